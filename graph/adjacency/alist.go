@@ -6,10 +6,16 @@ import (
 	"github.com/nfisher/goalgo/graph/errors"
 )
 
+// NewStrict returns an empty list that rejects self-loops and duplicate edges.
+func NewStrict() *List {
+	return &List{strict: true}
+}
+
 // List is an adjacency list using an array.
 type List struct {
-	list  [][]int
-	edges int
+	list   [][]int
+	edges  int
+	strict bool
 }
 
 // Vertex adds a new vertex, optionally with the specified edges.
@@ -17,8 +23,11 @@ func (as *List) Vertex(edges ...int) (id int, err error) {
 	var edgeSet = make([]int, 0, len(edges))
 	l := len(as.list)
 	for _, edge := range edges {
-		if edge >= l {
-			return -1, errors.ErrCannotAddVertices
+		if !as.valid(edge) {
+			return -1, errors.Vertices(errors.ErrCannotAddVertices, edge)
+		}
+		if as.strict && contains(edgeSet, edge) {
+			return -1, errors.Vertices(errors.ErrDuplicateEdge, l, edge)
 		}
 		edgeSet = append(edgeSet, edge)
 	}
@@ -31,13 +40,13 @@ func (as *List) Vertex(edges ...int) (id int, err error) {
 
 // Edge adds an edge from v to w.
 func (as *List) Edge(v, w int) error {
-	l := len(as.list)
-	if v >= l {
-		return errors.ErrCannotAddEdge
+	if !as.valid(v) {
+		return errors.Vertices(errors.ErrCannotAddEdge, v, w)
 	}
 
-	if w >= l {
-		return errors.ErrCannotAddEdge
+	err := as.check(v, w, as.list[v])
+	if err != nil {
+		return err
 	}
 
 	as.list[v] = append(as.list[v], w)
@@ -48,8 +57,8 @@ func (as *List) Edge(v, w int) error {
 
 // Adjacent returns all vertices adjacent to this vertex.
 func (as *List) Adjacent(v int) ([]int, error) {
-	if v >= len(as.list) {
-		return nil, errors.ErrVertexNotFound
+	if !as.valid(v) {
+		return nil, errors.Vertices(errors.ErrVertexNotFound, v)
 	}
 
 	var a []int
@@ -70,7 +79,22 @@ func (as *List) Edges() int {
 	return as.edges
 }
 
-// UnmarshalJSON populates the adjacency list from JSON.
+// Clone returns a deep copy of the list.
+func (as *List) Clone() *List {
+	list := make([][]int, len(as.list))
+	for v, edges := range as.list {
+		list[v] = append(make([]int, 0, len(edges)), edges...)
+	}
+
+	return &List{
+		list:   list,
+		edges:  as.edges,
+		strict: as.strict,
+	}
+}
+
+// UnmarshalJSON populates the adjacency list from JSON. Edges to vertices outside of the list, and in a strict
+// list self-loops and duplicates, are rejected with a VertexError and leave the list empty.
 func (as *List) UnmarshalJSON(b []byte) error {
 	err := json.Unmarshal(b, &as.list)
 	if err != nil {
@@ -78,8 +102,16 @@ func (as *List) UnmarshalJSON(b []byte) error {
 	}
 
 	var edges int
-	for _, v := range as.list {
-		edges += len(v)
+	for v, adj := range as.list {
+		for i, w := range adj {
+			err = as.check(v, w, adj[:i])
+			if err != nil {
+				as.list = nil
+				as.edges = 0
+				return err
+			}
+		}
+		edges += len(adj)
 	}
 	as.edges = edges
 
@@ -90,3 +122,31 @@ func (as *List) UnmarshalJSON(b []byte) error {
 func (as *List) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&as.list)
 }
+
+// valid returns true if v is a vertex in the list.
+func (as *List) valid(v int) bool {
+	return v >= 0 && v < len(as.list)
+}
+
+// check validates the edge v→w against the list and the edges that precede it from v.
+func (as *List) check(v, w int, preceding []int) error {
+	if !as.valid(w) {
+		return errors.Vertices(errors.ErrCannotAddEdge, v, w)
+	}
+	if as.strict && v == w {
+		return errors.Vertices(errors.ErrSelfLoop, v, w)
+	}
+	if as.strict && contains(preceding, w) {
+		return errors.Vertices(errors.ErrDuplicateEdge, v, w)
+	}
+	return nil
+}
+
+func contains(vs []int, w int) bool {
+	for _, v := range vs {
+		if v == w {
+			return true
+		}
+	}
+	return false
+}
@@ -75,7 +75,7 @@ func Test_degree(t *testing.T) {
 			if actual != tc.degree {
 				t.Errorf("OutDegree(%v) = %v, want %v", tc.vertex, actual, tc.degree)
 			}
-			if err != tc.err {
+			if !errors.Is(err, tc.err) {
 				t.Errorf("OutDegree(%v) err = %v, want %v", tc.vertex, err, tc.err)
 			}
 		})
@@ -93,6 +93,7 @@ func Test_adjacent(t *testing.T) {
 		{"no adjacent vertices", graph.New(graph.Vertices(2)), 1, nil, nil},
 		{"return adjacent vertices", graph.New(graph.Vertices(2), graph.Upward(map[int][]int{1: {0}})), 1, []int{0}, nil},
 		{"error on invalid vertex", graph.New(), 0, nil, errors.ErrVertexNotFound},
+		{"error on negative vertex", graph.New(graph.Vertices(1)), -1, nil, errors.ErrVertexNotFound},
 	}
 
 	for _, tc := range td {
@@ -101,7 +102,7 @@ func Test_adjacent(t *testing.T) {
 			if !reflect.DeepEqual(actual, tc.expected) {
 				t.Errorf("list.Adjacent(%v) = %v, want %v", tc.vertex, actual, tc.expected)
 			}
-			if err != tc.err {
+			if !errors.Is(err, tc.err) {
 				t.Errorf("list.Adjacent(%v) err = %v, want %v", tc.vertex, err, tc.err)
 			}
 		})
@@ -121,12 +122,17 @@ func Test_edge(t *testing.T) {
 		{"rejects edge with invalid vertices", graph.New(), 0, 1, 0, errors.ErrCannotAddEdge},
 		{"rejects edge with invalid v vertices", graph.New(graph.Vertices(1)), 1, 0, 0, errors.ErrCannotAddEdge},
 		{"rejects edge with invalid w vertice", graph.New(graph.Vertices(1)), 0, 1, 0, errors.ErrCannotAddEdge},
+		{"rejects edge with negative v vertice", graph.New(graph.Vertices(1)), -1, 0, 0, errors.ErrCannotAddEdge},
+		{"rejects edge with negative w vertice", graph.New(graph.Vertices(1)), 0, -1, 0, errors.ErrCannotAddEdge},
+		{"allows self-loop", graph.New(graph.Vertices(1)), 0, 0, 1, nil},
+		{"rejects self-loop when strict", strict(1), 0, 0, 0, errors.ErrSelfLoop},
+		{"rejects duplicate when strict", strict(2, 0, 1), 0, 1, 1, errors.ErrDuplicateEdge},
 	}
 
 	for _, tc := range td {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.list.Edge(tc.v, tc.w)
-			if tc.err != err {
+			if !errors.Is(err, tc.err) {
 				t.Errorf("list.Edge(%v, %v) = %v, want %v", tc.v, tc.w, err, tc.err)
 			}
 
@@ -149,6 +155,9 @@ func Test_vertices(t *testing.T) {
 		{"adds vertex to populated list", graph.New(graph.Vertices(1)), nil, 1, nil},
 		{"adds vertex with valid edge", graph.New(graph.Vertices(1)), []int{0}, 1, nil},
 		{"rejects vertex with invalid edge", graph.New(), []int{1}, -1, errors.ErrCannotAddVertices},
+		{"rejects vertex with negative edge", graph.New(graph.Vertices(1)), []int{-1}, -1, errors.ErrCannotAddVertices},
+		{"adds vertex with duplicate edges", graph.New(graph.Vertices(1)), []int{0, 0}, 1, nil},
+		{"rejects vertex with duplicate edges when strict", strict(1), []int{0, 0}, -1, errors.ErrDuplicateEdge},
 	}
 
 	for _, tc := range td {
@@ -157,7 +166,7 @@ func Test_vertices(t *testing.T) {
 			if id != tc.id {
 				t.Errorf("list.Add(%v) id = %v, want %v", tc.edges, id, tc.id)
 			}
-			if err != tc.err {
+			if !errors.Is(err, tc.err) {
 				t.Errorf("list.Add(%v) id = %v, want %v", tc.edges, err, tc.err)
 			}
 		})
@@ -220,4 +229,83 @@ func Test_DecodeJSON(t *testing.T) {
 	if as.Edges() != 4 {
 		t.Errorf("Edges() = %v, want 4", as.Edges())
 	}
+
+	expected := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{1: {0, 3}, 2: {1}, 3: {2}}))
+	if !graph.Equal(&as, expected) {
+		d, _ := graph.Diff(expected, &as)
+		t.Errorf("Equal() = false, want true\n%v", d)
+	}
+}
+
+func Test_DecodeJSON_rejects_invalid_edges(t *testing.T) {
+	for _, input := range []string{"[[1]]", "[[],[-1]]"} {
+		var as adjacency.List
+		err := json.Unmarshal([]byte(input), &as)
+		if !errors.Is(err, errors.ErrCannotAddEdge) {
+			t.Errorf("Unmarshal(%v) err = %v, want ErrCannotAddEdge", input, err)
+		}
+		if as.Vertices() != 0 {
+			t.Errorf("Vertices() = %v, want 0", as.Vertices())
+		}
+	}
+}
+
+// strict returns a strict list with n vertices and the edges specified as pairs.
+func strict(n int, edges ...int) graph.Graph {
+	g := graph.Strict()
+	graph.Vertices(n)(g)
+	for i := 0; i+1 < len(edges); i += 2 {
+		g.Edge(edges[i], edges[i+1])
+	}
+	return g
+}
+
+func Test_errors_report_offending_ids(t *testing.T) {
+	g := graph.New(graph.Vertices(2))
+
+	err := g.Edge(1, -3)
+	var ve *errors.VertexError
+	if !errors.As(err, &ve) {
+		t.Fatalf("Edge(1, -3) err = %T, want *VertexError", err)
+	}
+	if !reflect.DeepEqual(ve.IDs, []int{1, -3}) {
+		t.Errorf("IDs = %v, want [1 -3]", ve.IDs)
+	}
+
+	expected := "graph: cannot add edge with invalid vertices: 1, -3"
+	if err.Error() != expected {
+		t.Errorf("Error() = %v, want %v", err.Error(), expected)
+	}
+}
+
+func Test_strict_DecodeJSON(t *testing.T) {
+	td := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"accepts simple graph", "[[1],[0]]", nil},
+		{"rejects self-loop", "[[0]]", errors.ErrSelfLoop},
+		{"rejects duplicate edge", "[[1,1],[]]", errors.ErrDuplicateEdge},
+	}
+
+	for _, tc := range td {
+		t.Run(tc.name, func(t *testing.T) {
+			as := adjacency.NewStrict()
+			err := json.Unmarshal([]byte(tc.input), as)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("Unmarshal(%v) err = %v, want %v", tc.input, err, tc.err)
+			}
+		})
+	}
+}
+
+func Test_clone_preserves_strict(t *testing.T) {
+	c := adjacency.NewStrict().Clone()
+	c.Vertex()
+
+	err := c.Edge(0, 0)
+	if !errors.Is(err, errors.ErrSelfLoop) {
+		t.Errorf("Edge(0, 0) err = %v, want ErrSelfLoop", err)
+	}
 }
@@ -0,0 +1,44 @@
+//go:build go1.18
+// +build go1.18
+
+package adjacency_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/adjacency"
+	"github.com/nfisher/goalgo/graph/check"
+)
+
+func FuzzUnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{"[]", "[[]]", "[[],[0,3],[1],[2]]", "[[0]]", "[[1]]", "[[-1]]", "null", "[null]"} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var as adjacency.List
+		if err := json.Unmarshal(b, &as); err != nil {
+			return
+		}
+
+		if err := check.DegreeSum(&as); err != nil {
+			t.Fatalf("%s: %v", b, err)
+		}
+
+		out, err := json.Marshal(&as)
+		if err != nil {
+			t.Fatalf("Marshal() err = %v, want nil", err)
+		}
+
+		var again adjacency.List
+		if err := json.Unmarshal(out, &again); err != nil {
+			t.Fatalf("Unmarshal(%s) err = %v, want nil", out, err)
+		}
+		if !graph.Equal(&as, &again) {
+			d, _ := graph.Diff(&as, &again)
+			t.Fatalf("round trip of %s changed graph\n%v", b, d)
+		}
+	})
+}
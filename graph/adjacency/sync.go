@@ -0,0 +1,147 @@
+package adjacency
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// Sync is an adjacency list that is safe for concurrent use. Readers that need a consistent view for a long
+// running algorithm should take a Snapshot rather than hold the lock.
+type Sync struct {
+	mu   sync.RWMutex
+	list *List
+	// shared is true while the current list is referenced by a snapshot and must be copied before it is modified.
+	shared bool
+}
+
+// Vertex adds a new vertex, optionally with the specified edges.
+func (s *Sync) Vertex(edges ...int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writable().Vertex(edges...)
+}
+
+// Edge adds an edge from v to w.
+func (s *Sync) Edge(v, w int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writable().Edge(v, w)
+}
+
+// Adjacent returns all vertices adjacent to this vertex.
+func (s *Sync) Adjacent(v int) ([]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readable().Adjacent(v)
+}
+
+// Vertices returns the number of vertices in the list.
+func (s *Sync) Vertices() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readable().Vertices()
+}
+
+// Edges returns the number edges in the list.
+func (s *Sync) Edges() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readable().Edges()
+}
+
+// Snapshot returns an immutable view of the list at a point in time in O(1). The view and the list share memory
+// until the next write, which first copies the list while holding the write lock so the view never changes.
+func (s *Sync) Snapshot() *View {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.list == nil {
+		s.list = &List{}
+	}
+	s.shared = true
+	return &View{list: s.list}
+}
+
+// UnmarshalJSON populates the adjacency list from JSON.
+func (s *Sync) UnmarshalJSON(b []byte) error {
+	var l List
+	err := json.Unmarshal(b, &l)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list = &l
+	s.shared = false
+	return nil
+}
+
+// MarshalJSON encodes the adjacency list to JSON.
+func (s *Sync) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readable().MarshalJSON()
+}
+
+// readable returns the current list, the caller must hold at least the read lock.
+func (s *Sync) readable() *List {
+	if s.list == nil {
+		return &List{}
+	}
+	return s.list
+}
+
+// writable returns a list that no snapshot refers to, the caller must hold the write lock.
+func (s *Sync) writable() *List {
+	switch {
+	case s.list == nil:
+		s.list = &List{}
+	case s.shared:
+		s.list = s.list.Clone()
+		s.shared = false
+	}
+	return s.list
+}
+
+// View is an immutable snapshot of a Sync list. It satisfies graph.Graph so algorithms can run against it but
+// Vertex and Edge return ErrReadOnly.
+type View struct {
+	list *List
+}
+
+// Vertex always returns -1 and ErrReadOnly.
+func (v *View) Vertex(edges ...int) (int, error) {
+	return -1, errors.ErrReadOnly
+}
+
+// Edge always returns ErrReadOnly.
+func (v *View) Edge(from, to int) error {
+	return errors.ErrReadOnly
+}
+
+// Adjacent returns a copy of the vertices adjacent to this vertex.
+func (v *View) Adjacent(w int) ([]int, error) {
+	return v.list.Adjacent(w)
+}
+
+// Vertices returns the number of vertices in the view.
+func (v *View) Vertices() int {
+	return v.list.Vertices()
+}
+
+// Edges returns the number edges in the view.
+func (v *View) Edges() int {
+	return v.list.Edges()
+}
+
+// Clone returns a mutable deep copy of the view.
+func (v *View) Clone() *List {
+	return v.list.Clone()
+}
+
+// MarshalJSON encodes the view to JSON.
+func (v *View) MarshalJSON() ([]byte, error) {
+	return v.list.MarshalJSON()
+}
@@ -0,0 +1,127 @@
+package adjacency_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/adjacency"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+func Test_sync_concurrent_writers_and_readers(t *testing.T) {
+	const writers = 4
+	const edgesPerWriter = 200
+
+	g := graph.Concurrent()
+	for i := 0; i < 10; i++ {
+		g.Vertex()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < edgesPerWriter; j++ {
+				if err := g.Edge(i, j%10); err != nil {
+					t.Errorf("Edge(%v, %v) = %v, want nil", i, j%10, err)
+				}
+			}
+		}(i)
+	}
+
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < edgesPerWriter; j++ {
+				g.Adjacent(j % 10)
+				g.Edges()
+				s := g.Snapshot()
+				graph.Average(s)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if g.Edges() != writers*edgesPerWriter {
+		t.Errorf("Edges() = %v, want %v", g.Edges(), writers*edgesPerWriter)
+	}
+}
+
+func Test_sync_snapshot_is_isolated(t *testing.T) {
+	g := graph.Concurrent()
+	g.Vertex()
+	g.Vertex(0)
+
+	s := g.Snapshot()
+	g.Edge(1, 0)
+	g.Vertex()
+
+	if s.Vertices() != 2 {
+		t.Errorf("snapshot Vertices() = %v, want 2", s.Vertices())
+	}
+	if s.Edges() != 1 {
+		t.Errorf("snapshot Edges() = %v, want 1", s.Edges())
+	}
+
+	if err := s.Edge(0, 1); err != errors.ErrReadOnly {
+		t.Errorf("snapshot Edge() = %v, want ErrReadOnly", err)
+	}
+	if id, err := s.Vertex(); id != -1 || err != errors.ErrReadOnly {
+		t.Errorf("snapshot Vertex() = %v, %v, want -1, ErrReadOnly", id, err)
+	}
+	if g.Edges() != 2 || g.Vertices() != 3 {
+		t.Errorf("Edges(), Vertices() = %v, %v, want 2, 3", g.Edges(), g.Vertices())
+	}
+
+	adj, _ := g.Adjacent(0)
+	if len(adj) != 0 {
+		t.Errorf("Adjacent(0) = %v, want []", adj)
+	}
+}
+
+func Test_sync_snapshot_survives_writes_to_shared_list(t *testing.T) {
+	g := graph.Concurrent()
+	g.Vertex()
+
+	first := g.Snapshot()
+	second := g.Snapshot()
+	g.Vertex(0)
+	third := g.Snapshot()
+	g.Edge(1, 0)
+
+	for i, tc := range []struct {
+		s        *adjacency.View
+		vertices int
+		edges    int
+	}{{first, 1, 0}, {second, 1, 0}, {third, 2, 1}} {
+		if tc.s.Vertices() != tc.vertices || tc.s.Edges() != tc.edges {
+			t.Errorf("snapshot %v Vertices(), Edges() = %v, %v, want %v, %v",
+				i, tc.s.Vertices(), tc.s.Edges(), tc.vertices, tc.edges)
+		}
+	}
+}
+
+func Test_sync_json_round_trip(t *testing.T) {
+	var g adjacency.Sync
+	input := "[[],[0,3],[1],[2]]"
+
+	err := json.Unmarshal([]byte(input), &g)
+	if err != nil {
+		t.Fatalf("Unmarshal() err = %v, want nil", err)
+	}
+	if g.Edges() != 4 {
+		t.Errorf("Edges() = %v, want 4", g.Edges())
+	}
+
+	b, err := json.Marshal(&g)
+	if err != nil {
+		t.Fatalf("Marshal() err = %v, want nil", err)
+	}
+	if string(b) != input {
+		t.Errorf("Marshal() = %s, want %s", b, input)
+	}
+}
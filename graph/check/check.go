@@ -0,0 +1,233 @@
+// Package check generates random graphs and validates the invariants graph algorithms must uphold so that
+// properties can be tested over many graphs rather than a handful of hand built examples.
+package check
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/adjacency"
+)
+
+// Run evaluates property against runs random sources derived from seed, failing tb with the seed of the first
+// run that returns an error so it can be reproduced.
+func Run(tb testing.TB, seed int64, runs int, property func(r *rand.Rand) error) {
+	tb.Helper()
+	for i := 0; i < runs; i++ {
+		s := seed + int64(i)
+		err := property(rand.New(rand.NewSource(s)))
+		if err != nil {
+			tb.Fatalf("seed %d: %v", s, err)
+		}
+	}
+}
+
+// Random returns a directed graph with n vertices and the given number of edges chosen uniformly, including
+// self-loops and parallel edges.
+func Random(r *rand.Rand, n, edges int) *adjacency.List {
+	g := graph.Directed()
+	graph.Vertices(n)(g)
+	if n == 0 {
+		return g
+	}
+	for i := 0; i < edges; i++ {
+		g.Edge(r.Intn(n), r.Intn(n))
+	}
+	return g
+}
+
+// RandomDAG returns a directed acyclic graph with n vertices and up to the given number of edges. Vertices are
+// ranked by a random permutation and every edge points from a lower to a higher rank.
+func RandomDAG(r *rand.Rand, n, edges int) *adjacency.List {
+	g := graph.Directed()
+	graph.Vertices(n)(g)
+	if n < 2 {
+		return g
+	}
+
+	rank := r.Perm(n)
+	for i := 0; i < edges; i++ {
+		v, w := r.Intn(n), r.Intn(n)
+		if rank[v] == rank[w] {
+			continue
+		}
+		if rank[v] > rank[w] {
+			v, w = w, v
+		}
+		g.Edge(v, w)
+	}
+	return g
+}
+
+// TopologicalOrder returns an error unless order contains every vertex exactly once and every edge points from an
+// earlier vertex to a later one.
+func TopologicalOrder(g graph.Graph, order []int) error {
+	pos, err := permutation(g, order)
+	if err != nil {
+		return err
+	}
+
+	return eachEdge(g, func(v, w int) error {
+		if pos[v] >= pos[w] {
+			return fmt.Errorf("edge %d→%d violates order %v", v, w, order)
+		}
+		return nil
+	})
+}
+
+// Levels returns an error unless the levels partition the vertices and every edge points to a later level.
+func Levels(g graph.Graph, levels [][]int) error {
+	err := Partition(g, levels)
+	if err != nil {
+		return err
+	}
+
+	level := make([]int, g.Vertices())
+	for i, vs := range levels {
+		for _, v := range vs {
+			level[v] = i
+		}
+	}
+
+	return eachEdge(g, func(v, w int) error {
+		if level[v] >= level[w] {
+			return fmt.Errorf("edge %d→%d does not cross to a later level", v, w)
+		}
+		return nil
+	})
+}
+
+// Partition returns an error unless every vertex appears in exactly one of the sets.
+func Partition(g graph.Graph, sets [][]int) error {
+	var all []int
+	for _, s := range sets {
+		if len(s) == 0 {
+			return fmt.Errorf("partition contains an empty set")
+		}
+		all = append(all, s...)
+	}
+	_, err := permutation(g, all)
+	return err
+}
+
+// Assignment returns an error unless every vertex is assigned to a group numbered from 0 with no gaps.
+func Assignment(g graph.Graph, assignment []int) error {
+	if len(assignment) != g.Vertices() {
+		return fmt.Errorf("assignment has %d vertices, want %d", len(assignment), g.Vertices())
+	}
+
+	var groups int
+	for _, a := range assignment {
+		if a < 0 {
+			return fmt.Errorf("vertex assigned to negative group %d", a)
+		}
+		if a+1 > groups {
+			groups = a + 1
+		}
+	}
+
+	used := make([]bool, groups)
+	for _, a := range assignment {
+		used[a] = true
+	}
+	for i, u := range used {
+		if !u {
+			return fmt.Errorf("group %d is empty", i)
+		}
+	}
+	return nil
+}
+
+// DegreeSum returns an error unless the out-degrees and in-degrees each sum to the edge count, so the total degree
+// is twice the edge count, and every edge points to a vertex in the graph.
+func DegreeSum(g graph.Graph) error {
+	n := g.Vertices()
+	outDegree := make([]int, n)
+	inDegree := make([]int, n)
+	err := eachEdge(g, func(v, w int) error {
+		if w < 0 || w >= n {
+			return fmt.Errorf("edge %d→%d points outside the graph", v, w)
+		}
+		outDegree[v]++
+		inDegree[w]++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var out, in int
+	for v := 0; v < n; v++ {
+		out += outDegree[v]
+		in += inDegree[v]
+	}
+	if out != g.Edges() {
+		return fmt.Errorf("out-degree sum %d, want %d", out, g.Edges())
+	}
+	if in != g.Edges() {
+		return fmt.Errorf("in-degree sum %d, want %d", in, g.Edges())
+	}
+	return nil
+}
+
+// Path returns an error unless consecutive vertices in path are joined by an edge.
+func Path(g graph.Graph, path []int) error {
+	for i := 1; i < len(path); i++ {
+		adj, err := g.Adjacent(path[i-1])
+		if err != nil {
+			return err
+		}
+
+		var found bool
+		for _, w := range adj {
+			if w == path[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("path %v uses missing edge %d→%d", path, path[i-1], path[i])
+		}
+	}
+	return nil
+}
+
+// permutation returns the position of each vertex in vs or an error if vs is not a permutation of the vertices.
+func permutation(g graph.Graph, vs []int) ([]int, error) {
+	if len(vs) != g.Vertices() {
+		return nil, fmt.Errorf("%d vertices, want %d", len(vs), g.Vertices())
+	}
+
+	pos := make([]int, g.Vertices())
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i, v := range vs {
+		if v < 0 || v >= len(pos) {
+			return nil, fmt.Errorf("vertex %d not in graph", v)
+		}
+		if pos[v] != -1 {
+			return nil, fmt.Errorf("vertex %d appears more than once", v)
+		}
+		pos[v] = i
+	}
+	return pos, nil
+}
+
+func eachEdge(g graph.Graph, fn func(v, w int) error) error {
+	for v := 0; v < g.Vertices(); v++ {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return err
+		}
+		for _, w := range adj {
+			err = fn(v, w)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
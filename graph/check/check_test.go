@@ -0,0 +1,134 @@
+package check_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/check"
+)
+
+func chain() graph.Graph {
+	return graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}}))
+}
+
+func Test_topological_order_when(t *testing.T) {
+	tt := map[string]struct {
+		order []int
+		valid bool
+	}{
+		"valid":          {[]int{0, 1, 2}, true},
+		"violates edge":  {[]int{1, 0, 2}, false},
+		"missing vertex": {[]int{0, 1}, false},
+		"duplicate":      {[]int{0, 1, 1}, false},
+		"out of range":   {[]int{0, 1, 3}, false},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			err := check.TopologicalOrder(chain(), tc.order)
+			if (err == nil) != tc.valid {
+				t.Errorf("TopologicalOrder(%v) = %v, want valid = %v", tc.order, err, tc.valid)
+			}
+		})
+	}
+}
+
+func Test_levels_when(t *testing.T) {
+	tt := map[string]struct {
+		levels [][]int
+		valid  bool
+	}{
+		"valid":         {[][]int{{0}, {1}, {2}}, true},
+		"same level":    {[][]int{{0, 1}, {2}}, false},
+		"empty level":   {[][]int{{0}, {}, {1}, {2}}, false},
+		"missing level": {[][]int{{0}, {1}}, false},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			err := check.Levels(chain(), tc.levels)
+			if (err == nil) != tc.valid {
+				t.Errorf("Levels(%v) = %v, want valid = %v", tc.levels, err, tc.valid)
+			}
+		})
+	}
+}
+
+func Test_assignment_when(t *testing.T) {
+	tt := map[string]struct {
+		assignment []int
+		valid      bool
+	}{
+		"valid":     {[]int{0, 1, 0}, true},
+		"gap":       {[]int{0, 2, 0}, false},
+		"negative":  {[]int{0, -1, 0}, false},
+		"too short": {[]int{0, 0}, false},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			err := check.Assignment(chain(), tc.assignment)
+			if (err == nil) != tc.valid {
+				t.Errorf("Assignment(%v) = %v, want valid = %v", tc.assignment, err, tc.valid)
+			}
+		})
+	}
+}
+
+func Test_path(t *testing.T) {
+	if err := check.Path(chain(), []int{0, 1, 2}); err != nil {
+		t.Errorf("Path([0 1 2]) = %v, want nil", err)
+	}
+	if err := check.Path(chain(), []int{0, 2}); err == nil {
+		t.Errorf("Path([0 2]) = nil, want error")
+	}
+}
+
+// miscounted reports an edge count that disagrees with its adjacency lists.
+type miscounted struct {
+	graph.Graph
+	edges int
+}
+
+func (m miscounted) Edges() int {
+	return m.edges
+}
+
+func Test_degree_sum_when(t *testing.T) {
+	tt := map[string]struct {
+		g     graph.Graph
+		valid bool
+	}{
+		"valid":       {chain(), true},
+		"over count":  {miscounted{chain(), 3}, false},
+		"under count": {miscounted{chain(), 1}, false},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			err := check.DegreeSum(tc.g)
+			if (err == nil) != tc.valid {
+				t.Errorf("DegreeSum() = %v, want valid = %v", err, tc.valid)
+			}
+		})
+	}
+}
+
+func Test_generators(t *testing.T) {
+	check.Run(t, 1, 50, func(r *rand.Rand) error {
+		n := r.Intn(20)
+
+		g := check.Random(r, n, n*2)
+		if err := check.DegreeSum(g); err != nil {
+			return err
+		}
+
+		dag := check.RandomDAG(r, n, n*2)
+		order, err := graph.TopologicalSort(dag)
+		if err != nil {
+			return err
+		}
+		return check.TopologicalOrder(dag, order)
+	})
+}
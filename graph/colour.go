@@ -0,0 +1,182 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/nfisher/goalgo/graph/errors"
+	"github.com/nfisher/goalgo/sets/intset"
+)
+
+// GreedyColouring assigns each vertex the smallest colour not used by its neighbours, visiting vertices in the
+// order given which must contain every vertex once, otherwise ErrInvalidOrder is returned. A nil order visits the
+// vertices in ascending order. Colours are numbered from 0 and the graph is treated as undirected.
+func GreedyColouring(g Graph, order []int) ([]int, error) {
+	neighbours, err := neighbourSets(g)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		order = make([]int, g.Vertices())
+		for v := range order {
+			order[v] = v
+		}
+	}
+	if len(order) != g.Vertices() {
+		return nil, errors.ErrInvalidOrder
+	}
+
+	colours := uncoloured(g.Vertices())
+	for _, v := range order {
+		if v < 0 || v >= len(colours) {
+			return nil, errors.Vertices(errors.ErrVertexNotFound, v)
+		}
+		if colours[v] != -1 {
+			return nil, errors.Vertices(errors.ErrInvalidOrder, v)
+		}
+		colours[v] = smallestFree(neighbours[v], colours)
+	}
+
+	return colours, nil
+}
+
+// DSatur colours the graph by repeatedly colouring the vertex with the most distinctly coloured neighbours,
+// breaking ties by degree and then by the lowest id. Colours are numbered from 0 and the graph is treated as
+// undirected.
+func DSatur(g Graph) ([]int, error) {
+	neighbours, err := neighbourSets(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := g.Vertices()
+	colours := uncoloured(n)
+	saturation := make([]intset.Set, n)
+	for v := range saturation {
+		saturation[v] = intset.New()
+	}
+
+	for i := 0; i < n; i++ {
+		best := -1
+		for v := 0; v < n; v++ {
+			if colours[v] != -1 {
+				continue
+			}
+			if best == -1 ||
+				saturation[v].Len() > saturation[best].Len() ||
+				(saturation[v].Len() == saturation[best].Len() && neighbours[v].Len() > neighbours[best].Len()) {
+				best = v
+			}
+		}
+
+		c := smallestFree(neighbours[best], colours)
+		colours[best] = c
+		for w := range neighbours[best] {
+			saturation[w].Add(c)
+		}
+	}
+
+	return colours, nil
+}
+
+// MaximalCliques calls fn with every maximal clique in ascending vertex order using the Bron-Kerbosch algorithm
+// with pivoting. The graph is treated as undirected and self-loops are ignored. Returning true from fn will
+// terminate the enumeration.
+func MaximalCliques(g Graph, fn func(clique []int) bool) error {
+	neighbours, err := neighbourSets(g)
+	if err != nil {
+		return err
+	}
+
+	candidates := intset.New()
+	for v := range neighbours {
+		candidates.Add(v)
+	}
+
+	var clique []int
+	var expand func(p, x intset.Set) bool
+	expand = func(p, x intset.Set) bool {
+		if p.Len() == 0 {
+			if x.Len() == 0 && len(clique) > 0 {
+				found := append([]int{}, clique...)
+				sort.Ints(found)
+				return fn(found)
+			}
+			return false
+		}
+
+		// the pivot with the most candidate neighbours leaves the fewest branches to explore.
+		pivot, most := -1, -1
+		for _, set := range []intset.Set{p, x} {
+			for _, u := range set.Values() {
+				in := p.Intersection(neighbours[u])
+				if in.Len() > most {
+					pivot, most = u, in.Len()
+				}
+			}
+		}
+
+		for _, v := range p.Values() {
+			if neighbours[pivot].Contains(v) {
+				continue
+			}
+
+			clique = append(clique, v)
+			stop := expand(p.Intersection(neighbours[v]), x.Intersection(neighbours[v]))
+			clique = clique[:len(clique)-1]
+			if stop {
+				return true
+			}
+
+			p.Remove(v)
+			x.Add(v)
+		}
+		return false
+	}
+	expand(candidates, intset.New())
+
+	return nil
+}
+
+// neighbourSets returns the undirected neighbours of every vertex excluding the vertex itself.
+func neighbourSets(g Graph) ([]intset.Set, error) {
+	incident, _, err := undirected(g)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbours := make([]intset.Set, len(incident))
+	for v, arcs := range incident {
+		neighbours[v] = intset.New()
+		for _, a := range arcs {
+			if a.to != v {
+				neighbours[v].Add(a.to)
+			}
+		}
+	}
+	return neighbours, nil
+}
+
+func uncoloured(n int) []int {
+	colours := make([]int, n)
+	for v := range colours {
+		colours[v] = -1
+	}
+	return colours
+}
+
+// smallestFree returns the lowest colour not assigned to any of the neighbours.
+func smallestFree(neighbours intset.Set, colours []int) int {
+	used := intset.New()
+	for w := range neighbours {
+		if colours[w] != -1 {
+			used.Add(colours[w])
+		}
+	}
+
+	var c int
+	for used.Contains(c) {
+		c++
+	}
+	return c
+}
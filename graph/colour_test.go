@@ -0,0 +1,187 @@
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// cycle returns an undirected cycle of n vertices.
+func cycle(n int) graph.Graph {
+	g := graph.New(graph.Vertices(n))
+	for v := 0; v < n; v++ {
+		g.Edge(v, (v+1)%n)
+	}
+	return g
+}
+
+// complete returns an undirected complete graph of n vertices.
+func complete(n int) graph.Graph {
+	g := graph.New(graph.Vertices(n))
+	for v := 0; v < n; v++ {
+		for w := v + 1; w < n; w++ {
+			g.Edge(v, w)
+		}
+	}
+	return g
+}
+
+func Test_colouring_when(t *testing.T) {
+	tt := map[string]struct {
+		g       graph.Graph
+		colours int
+	}{
+		"empty graph":  {graph.New(), 0},
+		"single node":  {graph.New(graph.Vertices(1)), 1},
+		"even cycle":   {cycle(6), 2},
+		"odd cycle":    {cycle(5), 3},
+		"complete":     {complete(4), 4},
+		"self loop":    {graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {0, 1}})), 2},
+		"disconnected": {graph.New(graph.Vertices(3)), 1},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			colours, err := graph.DSatur(tc.g)
+			if err != nil {
+				t.Fatalf("DSatur() err = %v, want nil", err)
+			}
+			assertColouring(t, tc.g, colours)
+			if c := count(colours); c != tc.colours {
+				t.Errorf("DSatur() colours = %v, want %v", c, tc.colours)
+			}
+
+			greedy, err := graph.GreedyColouring(tc.g, nil)
+			if err != nil {
+				t.Fatalf("GreedyColouring() err = %v, want nil", err)
+			}
+			assertColouring(t, tc.g, greedy)
+		})
+	}
+}
+
+func Test_greedy_colouring_follows_order(t *testing.T) {
+	// a crown graph coloured alternating between the two sides needs a colour per pair.
+	g := graph.New(graph.Vertices(6), graph.Upward(map[int][]int{0: {3, 5}, 2: {1, 5}, 4: {1, 3}}))
+
+	colours, err := graph.GreedyColouring(g, []int{0, 1, 2, 3, 4, 5})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	assertColouring(t, g, colours)
+	if c := count(colours); c != 3 {
+		t.Errorf("colours = %v, want 3", c)
+	}
+
+	colours, err = graph.GreedyColouring(g, []int{0, 2, 4, 1, 3, 5})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	assertColouring(t, g, colours)
+	if !cmp.Equal(colours, []int{0, 1, 0, 1, 0, 1}) {
+		t.Errorf("colours = %v, want [0 1 0 1 0 1]", colours)
+	}
+
+	for _, order := range [][]int{{0, 1}, {0, 0, 1, 2, 3, 4}} {
+		if _, err := graph.GreedyColouring(g, order); !errors.Is(err, errors.ErrInvalidOrder) {
+			t.Errorf("GreedyColouring(%v) err = %v, want ErrInvalidOrder", order, err)
+		}
+	}
+	if _, err := graph.GreedyColouring(g, []int{0, 1, 2, 3, 4, 6}); !errors.Is(err, errors.ErrVertexNotFound) {
+		t.Errorf("GreedyColouring([0 1 2 3 4 6]) err = %v, want ErrVertexNotFound", err)
+	}
+}
+
+func Test_dsatur_on_random_graphs(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for run := 0; run < 50; run++ {
+		n := 1 + r.Intn(20)
+		g := graph.New(graph.Vertices(n))
+		for i := 0; i < n*2; i++ {
+			g.Edge(r.Intn(n), r.Intn(n))
+		}
+
+		colours, err := graph.DSatur(g)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		assertColouring(t, g, colours)
+	}
+}
+
+func assertColouring(t *testing.T, g graph.Graph, colours []int) {
+	t.Helper()
+	if len(colours) != g.Vertices() {
+		t.Fatalf("len(colours) = %v, want %v", len(colours), g.Vertices())
+	}
+	for v := 0; v < g.Vertices(); v++ {
+		if colours[v] < 0 {
+			t.Fatalf("vertex %v uncoloured", v)
+		}
+		adj, _ := g.Adjacent(v)
+		for _, w := range adj {
+			if v != w && colours[v] == colours[w] {
+				t.Fatalf("adjacent %v and %v share colour %v", v, w, colours[v])
+			}
+		}
+	}
+}
+
+func count(colours []int) int {
+	distinct := make(map[int]bool)
+	for _, c := range colours {
+		distinct[c] = true
+	}
+	return len(distinct)
+}
+
+func Test_maximal_cliques(t *testing.T) {
+	g := graph.New(graph.Vertices(7), graph.Upward(map[int][]int{
+		0: {1, 2},
+		1: {2, 3},
+		2: {3},
+		3: {4},
+		5: {5},
+	}))
+
+	var cliques [][]int
+	err := graph.MaximalCliques(g, func(c []int) bool {
+		cliques = append(cliques, c)
+		return false
+	})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	want := [][]int{{0, 1, 2}, {1, 2, 3}, {3, 4}, {5}, {6}}
+	if !cmp.Equal(cliques, want) {
+		t.Errorf("cliques (+want,-got)\n%s", cmp.Diff(cliques, want))
+	}
+}
+
+func Test_maximal_cliques_should_stop_when_fn_returns_true(t *testing.T) {
+	var calls int
+	graph.MaximalCliques(graph.New(graph.Vertices(5)), func([]int) bool {
+		calls++
+		return true
+	})
+	if calls != 1 {
+		t.Errorf("calls = %v, want 1", calls)
+	}
+}
+
+func Test_maximal_cliques_of_complete_graph(t *testing.T) {
+	var cliques [][]int
+	graph.MaximalCliques(complete(5), func(c []int) bool {
+		cliques = append(cliques, c)
+		return false
+	})
+
+	want := [][]int{{0, 1, 2, 3, 4}}
+	if !cmp.Equal(cliques, want) {
+		t.Errorf("cliques (+want,-got)\n%s", cmp.Diff(cliques, want))
+	}
+}
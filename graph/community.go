@@ -0,0 +1,305 @@
+package graph
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Communities is an assignment of every vertex to a community numbered from 0.
+type Communities struct {
+	Assignment []int
+	Modularity float64
+}
+
+// Count returns the number of communities.
+func (c *Communities) Count() int {
+	var n int
+	for _, a := range c.Assignment {
+		if a+1 > n {
+			n = a + 1
+		}
+	}
+	return n
+}
+
+// Modularity returns the modularity of the assignment over the undirected graph, the fraction of edges within
+// communities less the fraction expected if edges were placed at random preserving degrees.
+func Modularity(g Graph, assignment []int) (float64, error) {
+	w, err := newWeighted(g)
+	if err != nil {
+		return 0, err
+	}
+	return w.modularity(assignment), nil
+}
+
+// LabelPropagation detects communities by repeatedly moving each vertex, in a seeded random order, to the label
+// most common among its neighbours until no vertex changes. Ties are broken by the seeded source so results are
+// reproducible for a given seed. The graph is treated as undirected.
+func LabelPropagation(g Graph, seed int64) (*Communities, error) {
+	w, err := newWeighted(g)
+	if err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	labels := make([]int, w.n)
+	for v := range labels {
+		labels[v] = v
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, v := range r.Perm(w.n) {
+			counts := make(map[int]float64)
+			for _, e := range w.adj[v] {
+				if e.to != v {
+					counts[labels[e.to]] += e.weight
+				}
+			}
+			if len(counts) == 0 {
+				continue
+			}
+
+			var best []int
+			var most float64
+			for _, l := range sortedKeys(counts) {
+				switch {
+				case counts[l] > most:
+					best, most = []int{l}, counts[l]
+				case counts[l] == most:
+					best = append(best, l)
+				}
+			}
+
+			if counts[labels[v]] == most {
+				// staying put on a tie guarantees termination.
+				continue
+			}
+			labels[v] = best[r.Intn(len(best))]
+			changed = true
+		}
+	}
+
+	assignment := normalise(labels)
+	return &Communities{Assignment: assignment, Modularity: w.modularity(assignment)}, nil
+}
+
+// Louvain detects communities by greedily moving vertices between communities to maximise modularity, then
+// collapsing each community into a single vertex and repeating until modularity stops improving. Vertices are
+// visited in a seeded random order so results are reproducible for a given seed. The graph is treated as
+// undirected.
+func Louvain(g Graph, seed int64) (*Communities, error) {
+	w, err := newWeighted(g)
+	if err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	assignment := make([]int, w.n)
+	for v := range assignment {
+		assignment[v] = v
+	}
+
+	level := w
+	for {
+		local, moved := level.moveVertices(r)
+		if !moved {
+			break
+		}
+		for v := range assignment {
+			assignment[v] = local[assignment[v]]
+		}
+		level = level.aggregate(local)
+	}
+
+	assignment = normalise(assignment)
+	return &Communities{Assignment: assignment, Modularity: w.modularity(assignment)}, nil
+}
+
+type weightedEdge struct {
+	to     int
+	weight float64
+}
+
+// weighted is an undirected graph with edge weights where a self-loop is stored once with its full weight.
+type weighted struct {
+	n      int
+	adj    [][]weightedEdge
+	degree []float64
+	total  float64 // twice the total edge weight
+}
+
+func newWeighted(g Graph) (*weighted, error) {
+	w := &weighted{
+		n:      g.Vertices(),
+		adj:    make([][]weightedEdge, g.Vertices()),
+		degree: make([]float64, g.Vertices()),
+	}
+	for v := 0; v < w.n; v++ {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range adj {
+			w.add(v, x, 1)
+		}
+	}
+	return w, nil
+}
+
+func (w *weighted) add(v, x int, weight float64) {
+	w.adj[v] = append(w.adj[v], weightedEdge{x, weight})
+	if v != x {
+		w.adj[x] = append(w.adj[x], weightedEdge{v, weight})
+	}
+	w.degree[v] += weight
+	w.degree[x] += weight
+	w.total += 2 * weight
+}
+
+func (w *weighted) modularity(assignment []int) float64 {
+	if w.total == 0 {
+		return 0
+	}
+
+	internal := make(map[int]float64)
+	degrees := make(map[int]float64)
+	for v := 0; v < w.n; v++ {
+		degrees[assignment[v]] += w.degree[v]
+		for _, e := range w.adj[v] {
+			if assignment[e.to] != assignment[v] {
+				continue
+			}
+			if e.to == v {
+				// self-loops are only stored once but count from both ends.
+				internal[assignment[v]] += 2 * e.weight
+			} else {
+				internal[assignment[v]] += e.weight
+			}
+		}
+	}
+
+	var q float64
+	for _, c := range sortedKeys(degrees) {
+		d := degrees[c]
+		q += internal[c]/w.total - (d/w.total)*(d/w.total)
+	}
+	return q
+}
+
+// moveVertices is the local phase of Louvain, it returns the community of each vertex and whether any vertex moved.
+func (w *weighted) moveVertices(r *rand.Rand) ([]int, bool) {
+	community := make([]int, w.n)
+	totals := make([]float64, w.n)
+	for v := range community {
+		community[v] = v
+		totals[v] = w.degree[v]
+	}
+
+	var moved bool
+	for improved := true; improved; {
+		improved = false
+		for _, v := range r.Perm(w.n) {
+			links := make(map[int]float64)
+			for _, e := range w.adj[v] {
+				if e.to != v {
+					links[community[e.to]] += e.weight
+				}
+			}
+
+			current := community[v]
+			totals[current] -= w.degree[v]
+
+			// the gain of joining c is proportional to links[c] - totals[c]*degree/total.
+			best := current
+			bestGain := links[current] - totals[current]*w.degree[v]/w.total
+			for _, c := range sortedKeys(links) {
+				gain := links[c] - totals[c]*w.degree[v]/w.total
+				if gain > bestGain+1e-12 {
+					best, bestGain = c, gain
+				}
+			}
+
+			totals[best] += w.degree[v]
+			if best != current {
+				community[v] = best
+				improved = true
+				moved = true
+			}
+		}
+	}
+
+	return normalise(community), moved
+}
+
+// aggregate collapses each community into a single vertex keeping the total weight between communities.
+func (w *weighted) aggregate(community []int) *weighted {
+	var n int
+	for _, c := range community {
+		if c+1 > n {
+			n = c + 1
+		}
+	}
+
+	agg := &weighted{
+		n:      n,
+		adj:    make([][]weightedEdge, n),
+		degree: make([]float64, n),
+	}
+
+	weights := make(map[Edge]float64)
+	for v := 0; v < w.n; v++ {
+		for _, e := range w.adj[v] {
+			cv, cx := community[v], community[e.to]
+			switch {
+			case e.to == v:
+				weights[Edge{cv, cv}] += e.weight
+			case v < e.to:
+				if cx < cv {
+					cv, cx = cx, cv
+				}
+				weights[Edge{cv, cx}] += e.weight
+			}
+		}
+	}
+
+	edges := make([]Edge, 0, len(weights))
+	for e := range weights {
+		edges = append(edges, e)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].V != edges[j].V {
+			return edges[i].V < edges[j].V
+		}
+		return edges[i].W < edges[j].W
+	})
+	for _, e := range edges {
+		agg.add(e.V, e.W, weights[e])
+	}
+
+	return agg
+}
+
+// normalise renumbers the labels from 0 in order of first appearance.
+func normalise(labels []int) []int {
+	ids := make(map[int]int)
+	out := make([]int, len(labels))
+	for v, l := range labels {
+		id, ok := ids[l]
+		if !ok {
+			id = len(ids)
+			ids[l] = id
+		}
+		out[v] = id
+	}
+	return out
+}
+
+func sortedKeys(m map[int]float64) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+package graph_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nfisher/goalgo/graph"
+)
+
+// clusters returns k complete graphs of size n joined in a ring by single edges.
+func clusters(k, n int) graph.Graph {
+	g := graph.New(graph.Vertices(k * n))
+	for c := 0; c < k; c++ {
+		for v := 0; v < n; v++ {
+			for w := v + 1; w < n; w++ {
+				g.Edge(c*n+v, c*n+w)
+			}
+		}
+		g.Edge(c*n, ((c+1)%k)*n+n-1)
+	}
+	return g
+}
+
+func Test_modularity(t *testing.T) {
+	twoTriangles := graph.New(graph.Vertices(6), graph.Upward(map[int][]int{0: {1, 2}, 1: {2}, 2: {3}, 3: {4, 5}, 4: {5}}))
+
+	tt := map[string]struct {
+		g          graph.Graph
+		assignment []int
+		modularity float64
+	}{
+		"empty graph":    {graph.New(graph.Vertices(2)), []int{0, 1}, 0},
+		"one community":  {twoTriangles, []int{0, 0, 0, 0, 0, 0}, 0},
+		"two triangles":  {twoTriangles, []int{0, 0, 0, 1, 1, 1}, 6.0/7.0 - 0.5},
+		"singletons":     {graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}})), []int{0, 1}, -0.5},
+		"self loop only": {graph.New(graph.Vertices(1), graph.Upward(map[int][]int{0: {0}})), []int{0}, 0},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			q, err := graph.Modularity(tc.g, tc.assignment)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if math.Abs(q-tc.modularity) > 1e-9 {
+				t.Errorf("Modularity() = %v, want %v", q, tc.modularity)
+			}
+		})
+	}
+}
+
+func Test_community_detection_finds_clusters(t *testing.T) {
+	g := clusters(4, 5)
+	want := []int{0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, 3, 3, 3, 3, 3}
+
+	detectors := map[string]func(graph.Graph, int64) (*graph.Communities, error){
+		"louvain":           graph.Louvain,
+		"label propagation": graph.LabelPropagation,
+	}
+
+	for n, detect := range detectors {
+		t.Run(n, func(t *testing.T) {
+			c, err := detect(g, 1)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+			if c.Count() != 4 {
+				t.Errorf("Count() = %v, want 4", c.Count())
+			}
+			if !cmp.Equal(c.Assignment, want) {
+				t.Errorf("assignment (+want,-got)\n%s", cmp.Diff(c.Assignment, want))
+			}
+
+			q, _ := graph.Modularity(g, want)
+			if math.Abs(c.Modularity-q) > 1e-9 {
+				t.Errorf("Modularity = %v, want %v", c.Modularity, q)
+			}
+		})
+	}
+}
+
+func Test_community_detection_is_seeded(t *testing.T) {
+	r := rand.New(rand.NewSource(8))
+	g := randomGraph(r, 40, 100)
+
+	for _, detect := range []func(graph.Graph, int64) (*graph.Communities, error){graph.Louvain, graph.LabelPropagation} {
+		first, _ := detect(g, 42)
+		for i := 0; i < 5; i++ {
+			c, _ := detect(g, 42)
+			if !cmp.Equal(c, first) {
+				t.Fatalf("run %v: communities differ for the same seed", i)
+			}
+		}
+	}
+}
+
+func Test_louvain_improves_on_singletons(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	for run := 0; run < 20; run++ {
+		g := randomGraph(r, 30, 60)
+
+		singletons := make([]int, g.Vertices())
+		for v := range singletons {
+			singletons[v] = v
+		}
+		base, _ := graph.Modularity(g, singletons)
+
+		c, err := graph.Louvain(g, int64(run))
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if c.Modularity < base {
+			t.Errorf("run %v: Modularity = %v, want >= %v", run, c.Modularity, base)
+		}
+	}
+}
@@ -1 +1,231 @@
 package graph
+
+import "sort"
+
+// ArticulationPoints returns the vertices whose removal would disconnect the undirected graph, in ascending order.
+func ArticulationPoints(g Graph) ([]int, error) {
+	c, err := biconnect(g)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []int
+	for v, ok := range c.articulation {
+		if ok {
+			points = append(points, v)
+		}
+	}
+	return points, nil
+}
+
+// Bridges returns the edges whose removal would disconnect the undirected graph, ordered by source vertex.
+func Bridges(g Graph) ([]Edge, error) {
+	c, err := biconnect(g)
+	if err != nil {
+		return nil, err
+	}
+
+	bridges := make([]Edge, 0, len(c.bridges))
+	for _, id := range c.bridges {
+		bridges = append(bridges, c.edges[id])
+	}
+	return bridges, nil
+}
+
+// BiconnectedComponents partitions the edges of the undirected graph into maximal sets that remain connected
+// after the removal of any single vertex. Self-loops belong to no component.
+func BiconnectedComponents(g Graph) ([][]Edge, error) {
+	c, err := biconnect(g)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([][]Edge, 0, len(c.components))
+	for _, ids := range c.components {
+		sort.Ints(ids)
+		component := make([]Edge, 0, len(ids))
+		for _, id := range ids {
+			component = append(component, c.edges[id])
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
+
+// TwoEdgeConnectedComponents partitions the vertices of the undirected graph into maximal sets that remain
+// connected after the removal of any single edge. Each component is in ascending order.
+func TwoEdgeConnectedComponents(g Graph) ([][]int, error) {
+	c, err := biconnect(g)
+	if err != nil {
+		return nil, err
+	}
+
+	bridge := make(map[int]bool, len(c.bridges))
+	for _, id := range c.bridges {
+		bridge[id] = true
+	}
+
+	seen := make([]bool, len(c.incident))
+	var components [][]int
+	for s := range c.incident {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		component := []int{s}
+		stack := []int{s}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, a := range c.incident[v] {
+				if bridge[a.id] || seen[a.to] {
+					continue
+				}
+				seen[a.to] = true
+				component = append(component, a.to)
+				stack = append(stack, a.to)
+			}
+		}
+		sort.Ints(component)
+		components = append(components, component)
+	}
+	return components, nil
+}
+
+// arc is one direction of an undirected edge identified by its index in the edge list.
+type arc struct {
+	to, id int
+}
+
+// undirected returns the edges of g and the arcs incident to each vertex treating every edge as undirected.
+func undirected(g Graph) ([][]arc, []Edge, error) {
+	incident := make([][]arc, g.Vertices())
+	var edges []Edge
+	for v := range incident {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, w := range adj {
+			id := len(edges)
+			edges = append(edges, Edge{v, w})
+			incident[v] = append(incident[v], arc{w, id})
+			if v != w {
+				incident[w] = append(incident[w], arc{v, id})
+			}
+		}
+	}
+	return incident, edges, nil
+}
+
+type connectivity struct {
+	incident     [][]arc
+	edges        []Edge
+	articulation []bool
+	bridges      []int
+	components   [][]int
+}
+
+// biconnect runs an iterative Tarjan depth-first search over the undirected graph recording articulation points,
+// bridges and biconnected components by edge id.
+func biconnect(g Graph) (*connectivity, error) {
+	incident, edges, err := undirected(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(incident)
+	c := &connectivity{
+		incident:     incident,
+		edges:        edges,
+		articulation: make([]bool, n),
+	}
+
+	disc := make([]int, n)
+	low := make([]int, n)
+	for v := range disc {
+		disc[v] = -1
+	}
+
+	type frame struct {
+		v, parentEdge, next int
+	}
+
+	var timer int
+	var edgeStack []int
+	for s := range incident {
+		if disc[s] != -1 {
+			continue
+		}
+		disc[s] = timer
+		low[s] = timer
+		timer++
+
+		var rootChildren int
+		stack := []frame{{s, -1, 0}}
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+			if f.next < len(incident[f.v]) {
+				a := incident[f.v][f.next]
+				f.next++
+				if a.id == f.parentEdge || a.to == f.v {
+					continue
+				}
+
+				if disc[a.to] == -1 {
+					if f.v == s {
+						rootChildren++
+					}
+					edgeStack = append(edgeStack, a.id)
+					disc[a.to] = timer
+					low[a.to] = timer
+					timer++
+					stack = append(stack, frame{a.to, a.id, 0})
+				} else if disc[a.to] < disc[f.v] {
+					edgeStack = append(edgeStack, a.id)
+					if disc[a.to] < low[f.v] {
+						low[f.v] = disc[a.to]
+					}
+				}
+				continue
+			}
+
+			child := *f
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				break
+			}
+
+			p := stack[len(stack)-1].v
+			if low[child.v] < low[p] {
+				low[p] = low[child.v]
+			}
+			if low[child.v] > disc[p] {
+				c.bridges = append(c.bridges, child.parentEdge)
+			}
+			if low[child.v] >= disc[p] {
+				if p != s {
+					c.articulation[p] = true
+				}
+				var component []int
+				for {
+					id := edgeStack[len(edgeStack)-1]
+					edgeStack = edgeStack[:len(edgeStack)-1]
+					component = append(component, id)
+					if id == child.parentEdge {
+						break
+					}
+				}
+				c.components = append(c.components, component)
+			}
+		}
+
+		if rootChildren > 1 {
+			c.articulation[s] = true
+		}
+	}
+
+	sort.Ints(c.bridges)
+
+	return c, nil
+}
@@ -1,7 +1,195 @@
 package graph_test
 
-import "testing"
+import (
+	"math/rand"
+	"sort"
+	"testing"
 
-func Test_connected(t *testing.T) {
-	t.Skip("WIP")
+	"github.com/google/go-cmp/cmp"
+	"github.com/nfisher/goalgo/graph"
+)
+
+// network is two triangles joined by the bridge 2-3 with a tail 5-6.
+func network() graph.Graph {
+	return graph.New(graph.Vertices(7), graph.Upward(map[int][]int{
+		0: {1},
+		1: {2},
+		2: {0, 3},
+		3: {4},
+		4: {5},
+		5: {3, 6},
+	}))
+}
+
+func Test_articulation_points(t *testing.T) {
+	tt := map[string]struct {
+		g      graph.Graph
+		points []int
+	}{
+		"empty graph":      {graph.New(), nil},
+		"single edge":      {graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}})), nil},
+		"three node chain": {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{1: {0}, 2: {1}})), []int{1}},
+		"network":          {network(), []int{2, 3, 5}},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			points, err := graph.ArticulationPoints(tc.g)
+			if err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+			if !cmp.Equal(points, tc.points) {
+				t.Errorf("points (+want,-got)\n%s", cmp.Diff(points, tc.points))
+			}
+		})
+	}
+}
+
+func Test_bridges(t *testing.T) {
+	bridges, err := graph.Bridges(network())
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	want := []graph.Edge{{2, 3}, {5, 6}}
+	if !cmp.Equal(bridges, want) {
+		t.Errorf("bridges (+want,-got)\n%s", cmp.Diff(bridges, want))
+	}
+}
+
+func Test_parallel_edges_are_not_bridges(t *testing.T) {
+	g := graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}, 1: {0}}))
+
+	bridges, err := graph.Bridges(g)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if len(bridges) != 0 {
+		t.Errorf("bridges = %v, want []", bridges)
+	}
+}
+
+func Test_biconnected_components(t *testing.T) {
+	components, err := graph.BiconnectedComponents(network())
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	want := [][]graph.Edge{
+		{{5, 6}},
+		{{3, 4}, {4, 5}, {5, 3}},
+		{{2, 3}},
+		{{0, 1}, {1, 2}, {2, 0}},
+	}
+	if !cmp.Equal(components, want) {
+		t.Errorf("components (+want,-got)\n%s", cmp.Diff(components, want))
+	}
+}
+
+func Test_two_edge_connected_components(t *testing.T) {
+	components, err := graph.TwoEdgeConnectedComponents(network())
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	want := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}
+	if !cmp.Equal(components, want) {
+		t.Errorf("components (+want,-got)\n%s", cmp.Diff(components, want))
+	}
+}
+
+func Test_connectivity_matches_brute_force(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	for run := 0; run < 100; run++ {
+		n := 1 + r.Intn(10)
+		var edges []graph.Edge
+		g := graph.New(graph.Vertices(n))
+		for i := 0; i < n+r.Intn(n); i++ {
+			e := graph.Edge{V: r.Intn(n), W: r.Intn(n)}
+			g.Edge(e.V, e.W)
+			edges = append(edges, e)
+		}
+
+		base := components(n, edges, -1, -1)
+
+		points, _ := graph.ArticulationPoints(g)
+		var want []int
+		for v := 0; v < n; v++ {
+			// removing v also removes it as a component.
+			if components(n, edges, v, -1)-1 > base {
+				want = append(want, v)
+			}
+		}
+		if !cmp.Equal(points, want) {
+			t.Fatalf("run %v: ArticulationPoints() = %v, want %v", run, points, want)
+		}
+
+		bridges, _ := graph.Bridges(g)
+		var wantBridges []graph.Edge
+		for i, e := range edges {
+			if components(n, edges, -1, i) > base {
+				wantBridges = append(wantBridges, e)
+			}
+		}
+		sortEdges(bridges)
+		sortEdges(wantBridges)
+		if len(bridges) != len(wantBridges) || (len(bridges) > 0 && !cmp.Equal(bridges, wantBridges)) {
+			t.Fatalf("run %v: Bridges() = %v, want %v", run, bridges, wantBridges)
+		}
+
+		bcc, _ := graph.BiconnectedComponents(g)
+		var count int
+		for _, c := range bcc {
+			count += len(c)
+		}
+		var loops int
+		for _, e := range edges {
+			if e.V == e.W {
+				loops++
+			}
+		}
+		if count != len(edges)-loops {
+			t.Fatalf("run %v: components cover %v edges, want %v", run, count, len(edges)-loops)
+		}
+	}
+}
+
+// components counts the connected components ignoring vertex skipV and edge skipE.
+func components(n int, edges []graph.Edge, skipV, skipE int) int {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(x int) int {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+
+	for i, e := range edges {
+		if i == skipE || e.V == skipV || e.W == skipV {
+			continue
+		}
+		parent[find(e.V)] = find(e.W)
+	}
+
+	var count int
+	for v := 0; v < n; v++ {
+		if find(v) == v {
+			count++
+		}
+	}
+	return count
+}
+
+func sortEdges(edges []graph.Edge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].V != edges[j].V {
+			return edges[i].V < edges[j].V
+		}
+		return edges[i].W < edges[j].W
+	})
 }
@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/nfisher/goalgo/graph/adjacency"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// Acyclic returns a new directed graph that rejects edges which would introduce a cycle.
+func Acyclic() *DAG {
+	return &DAG{}
+}
+
+// DAG is a directed acyclic graph that maintains a topological order as edges are added using the
+// Pearce-Kelly dynamic topological sort. Only the region of the order between the two endpoints of an edge
+// is visited on insertion rather than the whole graph.
+type DAG struct {
+	list    adjacency.List
+	reverse [][]int
+	ord     []int // vertex -> position in order
+	order   []int // position -> vertex
+}
+
+// Vertex adds a new vertex, optionally with edges to the specified vertices.
+func (d *DAG) Vertex(edges ...int) (int, error) {
+	l := d.list.Vertices()
+	for _, w := range edges {
+		if w < 0 || w >= l {
+			return -1, errors.Vertices(errors.ErrCannotAddVertices, w)
+		}
+	}
+
+	v, err := d.list.Vertex()
+	if err != nil {
+		return -1, err
+	}
+	d.reverse = append(d.reverse, nil)
+	d.ord = append(d.ord, len(d.order))
+	d.order = append(d.order, v)
+
+	// a new vertex has no inbound edges so its outbound edges cannot form a cycle.
+	for _, w := range edges {
+		err = d.Edge(v, w)
+		if err != nil {
+			return -1, err
+		}
+	}
+
+	return v, nil
+}
+
+// Edge adds an edge from v to w, returning ErrCyclicEdge if w can already reach v.
+func (d *DAG) Edge(v, w int) error {
+	l := d.list.Vertices()
+	if v < 0 || v >= l || w < 0 || w >= l {
+		return errors.Vertices(errors.ErrCannotAddEdge, v, w)
+	}
+
+	if v == w {
+		return errors.Vertices(errors.ErrCyclicEdge, v, w)
+	}
+
+	lb, ub := d.ord[w], d.ord[v]
+	if lb < ub {
+		forward, ok := d.forward(w, v, ub)
+		if !ok {
+			return errors.Vertices(errors.ErrCyclicEdge, v, w)
+		}
+		backward := d.backward(v, lb)
+		d.reorder(backward, forward)
+	}
+
+	err := d.list.Edge(v, w)
+	if err != nil {
+		return err
+	}
+	d.reverse[w] = append(d.reverse[w], v)
+
+	return nil
+}
+
+// forward collects the vertices reachable from w positioned at or before ub. It returns false if target is
+// reachable.
+func (d *DAG) forward(w, target, ub int) ([]int, bool) {
+	visited := map[int]bool{w: true}
+	stack := []int{w}
+	var found []int
+
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		found = append(found, n)
+
+		adj, _ := d.list.Adjacent(n)
+		for _, m := range adj {
+			if m == target {
+				return nil, false
+			}
+			if !visited[m] && d.ord[m] < ub {
+				visited[m] = true
+				stack = append(stack, m)
+			}
+		}
+	}
+
+	return found, true
+}
+
+// backward collects the vertices that can reach v positioned after lb.
+func (d *DAG) backward(v, lb int) []int {
+	visited := map[int]bool{v: true}
+	stack := []int{v}
+	var found []int
+
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		found = append(found, n)
+
+		for _, m := range d.reverse[n] {
+			if !visited[m] && d.ord[m] > lb {
+				visited[m] = true
+				stack = append(stack, m)
+			}
+		}
+	}
+
+	return found
+}
+
+// reorder moves the backward set ahead of the forward set reusing the positions they already occupy.
+func (d *DAG) reorder(backward, forward []int) {
+	byOrd := func(vs []int) {
+		sort.Slice(vs, func(i, j int) bool { return d.ord[vs[i]] < d.ord[vs[j]] })
+	}
+	byOrd(backward)
+	byOrd(forward)
+
+	vertices := append(backward, forward...)
+	positions := make([]int, 0, len(vertices))
+	for _, n := range vertices {
+		positions = append(positions, d.ord[n])
+	}
+	sort.Ints(positions)
+
+	for i, n := range vertices {
+		d.ord[n] = positions[i]
+		d.order[positions[i]] = n
+	}
+}
+
+// Adjacent returns all vertices adjacent to this vertex.
+func (d *DAG) Adjacent(v int) ([]int, error) {
+	return d.list.Adjacent(v)
+}
+
+// Vertices returns the number of vertices in the graph.
+func (d *DAG) Vertices() int {
+	return d.list.Vertices()
+}
+
+// Edges returns the number edges in the graph.
+func (d *DAG) Edges() int {
+	return d.list.Edges()
+}
+
+// Order returns the vertices in the topological order currently maintained by the graph.
+func (d *DAG) Order() []int {
+	return append([]int{}, d.order...)
+}
@@ -0,0 +1,120 @@
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+func Test_dag_should_reject_cyclic_edges(t *testing.T) {
+	tt := map[string]struct {
+		edges [][2]int
+		v, w  int
+	}{
+		"self loop":       {nil, 0, 0},
+		"two node cycle":  {[][2]int{{0, 1}}, 1, 0},
+		"long cycle":      {[][2]int{{0, 1}, {1, 2}, {2, 3}}, 3, 0},
+		"reordered cycle": {[][2]int{{3, 2}, {2, 1}, {1, 0}}, 0, 3},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			g := graph.Acyclic()
+			for i := 0; i < 4; i++ {
+				g.Vertex()
+			}
+			for _, e := range tc.edges {
+				if err := g.Edge(e[0], e[1]); err != nil {
+					t.Fatalf("Edge(%v, %v) = %v, want nil", e[0], e[1], err)
+				}
+			}
+
+			err := g.Edge(tc.v, tc.w)
+			if !errors.Is(err, errors.ErrCyclicEdge) {
+				t.Errorf("Edge(%v, %v) = %v, want ErrCyclicEdge", tc.v, tc.w, err)
+			}
+			if g.Edges() != len(tc.edges) {
+				t.Errorf("Edges() = %v, want %v", g.Edges(), len(tc.edges))
+			}
+		})
+	}
+}
+
+func Test_dag_invalid_vertices(t *testing.T) {
+	g := graph.Acyclic()
+	g.Vertex()
+
+	if err := g.Edge(0, 1); !errors.Is(err, errors.ErrCannotAddEdge) {
+		t.Errorf("Edge(0, 1) = %v, want ErrCannotAddEdge", err)
+	}
+	if _, err := g.Vertex(2); !errors.Is(err, errors.ErrCannotAddVertices) {
+		t.Errorf("Vertex(2) = %v, want ErrCannotAddVertices", err)
+	}
+}
+
+func Test_dag_vertex_with_edges(t *testing.T) {
+	g := graph.Acyclic()
+	g.Vertex()
+	g.Vertex(0)
+	g.Vertex(1, 0)
+
+	assertTopological(t, g, g.Order())
+}
+
+func Test_dag_order_matches_random_insertions(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for run := 0; run < 50; run++ {
+		n := 2 + r.Intn(20)
+		g := graph.Acyclic()
+		for i := 0; i < n; i++ {
+			g.Vertex()
+		}
+
+		for i := 0; i < n*3; i++ {
+			v, w := r.Intn(n), r.Intn(n)
+			err := g.Edge(v, w)
+
+			shadow := graph.New(graph.Vertices(n))
+			for u := 0; u < n; u++ {
+				adj, _ := g.Adjacent(u)
+				for _, x := range adj {
+					shadow.Edge(u, x)
+				}
+			}
+			if errors.Is(err, errors.ErrCyclicEdge) {
+				shadow.Edge(v, w)
+				if _, err := graph.TopologicalSort(shadow); err != graph.ErrCyclicGraph {
+					t.Fatalf("run %v: Edge(%v, %v) rejected but graph is acyclic", run, v, w)
+				}
+			} else if err != nil {
+				t.Fatalf("run %v: Edge(%v, %v) = %v, want nil", run, v, w, err)
+			}
+
+			assertTopological(t, g, g.Order())
+		}
+	}
+}
+
+func assertTopological(t *testing.T, g graph.Graph, order []int) {
+	t.Helper()
+	if len(order) != g.Vertices() {
+		t.Fatalf("len(order) = %v, want %v", len(order), g.Vertices())
+	}
+
+	pos := make(map[int]int, len(order))
+	for i, v := range order {
+		pos[v] = i
+	}
+
+	for v := 0; v < g.Vertices(); v++ {
+		adj, _ := g.Adjacent(v)
+		for _, w := range adj {
+			if pos[v] >= pos[w] {
+				t.Fatalf("edge %v→%v violates order %v", v, w, order)
+			}
+		}
+	}
+}
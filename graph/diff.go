@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Equal returns true if both graphs have the same number of vertices and the same edges from every vertex,
+// ignoring the order edges were added.
+func Equal(a, b Graph) bool {
+	d, err := Diff(a, b)
+	if err != nil {
+		return false
+	}
+	return d.Empty()
+}
+
+// Difference lists the vertices and edges that were added or removed between two versions of a graph. Parallel
+// edges are counted so an edge appears once for every copy added or removed.
+type Difference struct {
+	AddedVertices   []int
+	RemovedVertices []int
+	AddedEdges      []Edge
+	RemovedEdges    []Edge
+}
+
+// Empty returns true when there are no changes.
+func (d *Difference) Empty() bool {
+	return len(d.AddedVertices) == 0 && len(d.RemovedVertices) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0
+}
+
+// String renders one change per line prefixed with + for additions and - for removals.
+func (d *Difference) String() string {
+	var b strings.Builder
+	for _, v := range d.AddedVertices {
+		fmt.Fprintf(&b, "+ vertex %d\n", v)
+	}
+	for _, v := range d.RemovedVertices {
+		fmt.Fprintf(&b, "- vertex %d\n", v)
+	}
+	for _, e := range d.AddedEdges {
+		fmt.Fprintf(&b, "+ edge %d -> %d\n", e.V, e.W)
+	}
+	for _, e := range d.RemovedEdges {
+		fmt.Fprintf(&b, "- edge %d -> %d\n", e.V, e.W)
+	}
+	return b.String()
+}
+
+// Diff returns the changes required to turn from into to. Vertices are matched by id so vertices beyond the end of
+// the smaller graph are reported as added or removed along with their edges.
+func Diff(from, to Graph) (*Difference, error) {
+	d := &Difference{}
+	for v := from.Vertices(); v < to.Vertices(); v++ {
+		d.AddedVertices = append(d.AddedVertices, v)
+	}
+	for v := to.Vertices(); v < from.Vertices(); v++ {
+		d.RemovedVertices = append(d.RemovedVertices, v)
+	}
+
+	n := from.Vertices()
+	if to.Vertices() > n {
+		n = to.Vertices()
+	}
+
+	for v := 0; v < n; v++ {
+		before, err := sortedAdjacent(from, v)
+		if err != nil {
+			return nil, err
+		}
+		after, err := sortedAdjacent(to, v)
+		if err != nil {
+			return nil, err
+		}
+
+		i, j := 0, 0
+		for i < len(before) || j < len(after) {
+			switch {
+			case j == len(after) || (i < len(before) && before[i] < after[j]):
+				d.RemovedEdges = append(d.RemovedEdges, Edge{v, before[i]})
+				i++
+			case i == len(before) || after[j] < before[i]:
+				d.AddedEdges = append(d.AddedEdges, Edge{v, after[j]})
+				j++
+			default:
+				i++
+				j++
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// sortedAdjacent returns the sorted adjacent vertices of v or none when v is not in the graph.
+func sortedAdjacent(g Graph, v int) ([]int, error) {
+	if v >= g.Vertices() {
+		return nil, nil
+	}
+	adj, err := g.Adjacent(v)
+	if err != nil {
+		return nil, err
+	}
+	// Adjacent may return the graph's own storage so sort a copy.
+	sorted := append([]int(nil), adj...)
+	sort.Ints(sorted)
+	return sorted, nil
+}
@@ -0,0 +1,102 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nfisher/goalgo/graph"
+)
+
+func Test_equal_when(t *testing.T) {
+	tt := map[string]struct {
+		a, b  graph.Graph
+		equal bool
+	}{
+		"both empty":           {graph.New(), graph.New(), true},
+		"same edges reordered": {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1, 2}})), graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {2, 1}})), true},
+		"upward and downward":  {exampleGraph(), reverso(), false},
+		"transposed reverse":   {exampleGraph(), transposed(reverso()), true},
+		"vertex count differs": {graph.New(graph.Vertices(1)), graph.New(graph.Vertices(2)), false},
+		"parallel edge":        {graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1, 1}})), graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}})), false},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			if got := graph.Equal(tc.a, tc.b); got != tc.equal {
+				t.Errorf("Equal() = %v, want %v", got, tc.equal)
+			}
+		})
+	}
+}
+
+func transposed(g graph.Graph) graph.Graph {
+	tr, _ := graph.Transpose(g)
+	return tr
+}
+
+func Test_diff(t *testing.T) {
+	from := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2}, 1: {3}, 3: {0, 0}}))
+	to := graph.New(graph.Vertices(5), graph.Upward(map[int][]int{0: {2, 4}, 1: {3}, 3: {0}, 4: {1}}))
+
+	d, err := graph.Diff(from, to)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	want := &graph.Difference{
+		AddedVertices: []int{4},
+		AddedEdges:    []graph.Edge{{0, 4}, {4, 1}},
+		RemovedEdges:  []graph.Edge{{0, 1}, {3, 0}},
+	}
+	if !cmp.Equal(d, want) {
+		t.Errorf("Diff() (+want,-got)\n%s", cmp.Diff(d, want))
+	}
+
+	s := "+ vertex 4\n+ edge 0 -> 4\n+ edge 4 -> 1\n- edge 0 -> 1\n- edge 3 -> 0\n"
+	if d.String() != s {
+		t.Errorf("String() = %q, want %q", d.String(), s)
+	}
+
+	reverse, _ := graph.Diff(to, from)
+	want = &graph.Difference{
+		RemovedVertices: []int{4},
+		AddedEdges:      []graph.Edge{{0, 1}, {3, 0}},
+		RemovedEdges:    []graph.Edge{{0, 4}, {4, 1}},
+	}
+	if !cmp.Equal(reverse, want) {
+		t.Errorf("Diff() reversed (+want,-got)\n%s", cmp.Diff(reverse, want))
+	}
+}
+
+func Test_diff_of_equal_graphs_is_empty(t *testing.T) {
+	d, err := graph.Diff(exampleGraph(), exampleGraph())
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if !d.Empty() {
+		t.Errorf("Empty() = false, want true\n%v", d)
+	}
+}
+
+// shared exposes its adjacency slices directly rather than returning copies.
+type shared struct {
+	graph.Graph
+	adj [][]int
+}
+
+func (s shared) Adjacent(v int) ([]int, error) {
+	return s.adj[v], nil
+}
+
+func Test_equal_does_not_reorder_adjacent(t *testing.T) {
+	g := shared{graph.New(graph.Vertices(3)), [][]int{{2, 1}, {}, {}}}
+
+	graph.Equal(g, g)
+	if _, err := graph.Diff(g, g); err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	if !cmp.Equal(g.adj[0], []int{2, 1}) {
+		t.Errorf("Adjacent(0) = %v, want [2 1]", g.adj[0])
+	}
+}
@@ -0,0 +1,309 @@
+package graph
+
+import (
+	"github.com/nfisher/goalgo/graph/adjacency"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// DominatorTree records the immediate dominator of every vertex reachable from the root. A vertex d dominates v
+// if every path from the root to v passes through d.
+type DominatorTree struct {
+	root int
+	idom []int
+}
+
+// Dominators computes the dominator tree of g from root using the Lengauer-Tarjan algorithm.
+func Dominators(g Graph, root int) (*DominatorTree, error) {
+	n := g.Vertices()
+	if root < 0 || root >= n {
+		return nil, errors.ErrVertexNotFound
+	}
+
+	const none = -1
+	semi := make([]int, n)
+	vertex := make([]int, 0, n)
+	parent := make([]int, n)
+	ancestor := make([]int, n)
+	label := make([]int, n)
+	idom := make([]int, n)
+	pred := make([][]int, n)
+	bucket := make([][]int, n)
+	for v := range semi {
+		semi[v] = none
+		parent[v] = none
+		ancestor[v] = none
+		label[v] = v
+		idom[v] = none
+	}
+
+	// number the vertices in depth-first preorder.
+	type frame struct {
+		v   int
+		adj []int
+	}
+	adj, err := g.Adjacent(root)
+	if err != nil {
+		return nil, err
+	}
+	semi[root] = 0
+	vertex = append(vertex, root)
+	stack := []frame{{root, adj}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if len(top.adj) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		w := top.adj[0]
+		top.adj = top.adj[1:]
+		pred[w] = append(pred[w], top.v)
+		if semi[w] != none {
+			continue
+		}
+
+		parent[w] = top.v
+		semi[w] = len(vertex)
+		vertex = append(vertex, w)
+		adj, err := g.Adjacent(w)
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, frame{w, adj})
+	}
+
+	compress := func(v int) {
+		var path []int
+		for u := v; ancestor[ancestor[u]] != none; u = ancestor[u] {
+			path = append(path, u)
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			u := path[i]
+			a := ancestor[u]
+			if semi[label[a]] < semi[label[u]] {
+				label[u] = label[a]
+			}
+			ancestor[u] = ancestor[a]
+		}
+	}
+
+	eval := func(v int) int {
+		if ancestor[v] == none {
+			return v
+		}
+		compress(v)
+		return label[v]
+	}
+
+	for i := len(vertex) - 1; i > 0; i-- {
+		w := vertex[i]
+		for _, v := range pred[w] {
+			u := eval(v)
+			if semi[u] < semi[w] {
+				semi[w] = semi[u]
+			}
+		}
+		bucket[vertex[semi[w]]] = append(bucket[vertex[semi[w]]], w)
+
+		p := parent[w]
+		ancestor[w] = p
+		for _, v := range bucket[p] {
+			u := eval(v)
+			if semi[u] < semi[v] {
+				idom[v] = u
+			} else {
+				idom[v] = p
+			}
+		}
+		bucket[p] = nil
+	}
+
+	for _, w := range vertex[1:] {
+		if idom[w] != vertex[semi[w]] {
+			idom[w] = idom[idom[w]]
+		}
+	}
+
+	return &DominatorTree{root: root, idom: idom}, nil
+}
+
+// Root returns the vertex the dominator tree was computed from.
+func (d *DominatorTree) Root() int {
+	return d.root
+}
+
+// Idom returns the immediate dominator of v.
+func (d *DominatorTree) Idom(v int) (int, error) {
+	if v < 0 || v >= len(d.idom) {
+		return -1, errors.ErrVertexNotFound
+	}
+	if d.idom[v] == -1 {
+		return -1, errors.ErrNoDominator
+	}
+	return d.idom[v], nil
+}
+
+// Dominates returns true if a dominates b. Every reachable vertex dominates itself.
+func (d *DominatorTree) Dominates(a, b int) bool {
+	if a < 0 || a >= len(d.idom) || b < 0 || b >= len(d.idom) {
+		return false
+	}
+	if b != d.root && d.idom[b] == -1 {
+		return false
+	}
+	for v := b; v != -1; v = d.idom[v] {
+		if v == a {
+			return true
+		}
+	}
+	return false
+}
+
+// Graph returns the dominator tree as a new graph with an edge from each immediate dominator to the vertices it
+// dominates. Vertices unreachable from the root have no edges.
+func (d *DominatorTree) Graph() *adjacency.List {
+	t := withVertices(len(d.idom))
+	for v, p := range d.idom {
+		if p != -1 {
+			t.Edge(p, v)
+		}
+	}
+	return t
+}
+
+// LCA returns lowest common ancestor queries over the dominator tree. A query answers the nearest vertex that
+// dominates both arguments, the deepest vertex that lies on every path from the root to either of them.
+// This is the LCA to use for a DAG, where a vertex may have several parents and no single ancestor chain.
+func (d *DominatorTree) LCA() *LCA {
+	return newLCA(d.root, d.idom)
+}
+
+// LCA answers lowest common ancestor queries on a rooted tree using binary lifting.
+type LCA struct {
+	up    [][]int
+	depth []int
+}
+
+// NewLCA prepares lowest common ancestor queries for the tree in g rooted at root. It returns ErrNotTree if a
+// vertex reachable from the root has more than one parent, so DAGs are not accepted. For a DAG use
+// Dominators(g, root) followed by LCA() which answers the nearest common dominator of two vertices, the deepest
+// vertex that lies on every path from the root to both of them.
+func NewLCA(g Graph, root int) (*LCA, error) {
+	n := g.Vertices()
+	if root < 0 || root >= n {
+		return nil, errors.ErrVertexNotFound
+	}
+
+	parent := make([]int, n)
+	seen := make([]bool, n)
+	for v := range parent {
+		parent[v] = -1
+	}
+	seen[root] = true
+
+	queue := []int{root}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range adj {
+			if seen[w] {
+				return nil, errors.ErrNotTree
+			}
+			seen[w] = true
+			parent[w] = v
+			queue = append(queue, w)
+		}
+	}
+
+	return newLCA(root, parent), nil
+}
+
+// newLCA builds the binary lifting table from a parent array where the root and unreachable vertices have -1.
+func newLCA(root int, parent []int) *LCA {
+	n := len(parent)
+	depth := make([]int, n)
+	for v := range depth {
+		depth[v] = -1
+	}
+	depth[root] = 0
+
+	var levels int
+	for 1<<uint(levels) < n {
+		levels++
+	}
+	levels++
+
+	up := make([][]int, levels)
+	up[0] = make([]int, n)
+	for v := range parent {
+		up[0][v] = parent[v]
+		if v == root {
+			up[0][v] = root
+		}
+	}
+
+	for v := range depth {
+		var path []int
+		u := v
+		for depth[u] == -1 && parent[u] != -1 {
+			path = append(path, u)
+			u = parent[u]
+		}
+		if depth[u] == -1 {
+			// unreachable from the root.
+			continue
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			depth[path[i]] = depth[parent[path[i]]] + 1
+		}
+	}
+
+	for k := 1; k < levels; k++ {
+		up[k] = make([]int, n)
+		for v := range up[k] {
+			if up[k-1][v] == -1 {
+				up[k][v] = -1
+				continue
+			}
+			up[k][v] = up[k-1][up[k-1][v]]
+		}
+	}
+
+	return &LCA{up: up, depth: depth}
+}
+
+// Query returns the lowest common ancestor of v and w.
+func (l *LCA) Query(v, w int) (int, error) {
+	n := len(l.depth)
+	if v < 0 || v >= n || w < 0 || w >= n || l.depth[v] == -1 || l.depth[w] == -1 {
+		return -1, errors.ErrVertexNotFound
+	}
+
+	if l.depth[v] < l.depth[w] {
+		v, w = w, v
+	}
+
+	diff := l.depth[v] - l.depth[w]
+	for k := 0; diff > 0; k++ {
+		if diff&1 == 1 {
+			v = l.up[k][v]
+		}
+		diff >>= 1
+	}
+
+	if v == w {
+		return v, nil
+	}
+
+	for k := len(l.up) - 1; k >= 0; k-- {
+		if l.up[k][v] != l.up[k][w] {
+			v = l.up[k][v]
+			w = l.up[k][w]
+		}
+	}
+
+	return l.up[0][v], nil
+}
@@ -0,0 +1,155 @@
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+func Test_dominators(t *testing.T) {
+	g := graph.New(graph.Vertices(7), graph.Upward(map[int][]int{
+		0: {1},
+		1: {2, 3},
+		2: {4},
+		3: {4},
+		4: {5},
+		5: {1},
+	}))
+
+	d, err := graph.Dominators(g, 0)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	want := map[int]int{1: 0, 2: 1, 3: 1, 4: 1, 5: 4}
+	for v, idom := range want {
+		got, err := d.Idom(v)
+		if err != nil || got != idom {
+			t.Errorf("Idom(%v) = %v, %v, want %v, nil", v, got, err, idom)
+		}
+	}
+
+	for _, v := range []int{0, 6} {
+		if _, err := d.Idom(v); err != errors.ErrNoDominator {
+			t.Errorf("Idom(%v) err = %v, want ErrNoDominator", v, err)
+		}
+	}
+
+	if _, err := d.Idom(7); err != errors.ErrVertexNotFound {
+		t.Errorf("Idom(7) err = %v, want ErrVertexNotFound", err)
+	}
+
+	if got := toJSON(t, d.Graph()); got != "[[1],[2,3,4],[],[],[5],[],[]]" {
+		t.Errorf("Graph() = %v, want [[1],[2,3,4],[],[],[5],[],[]]", got)
+	}
+
+	ncd, err := d.LCA().Query(5, 2)
+	if err != nil || ncd != 1 {
+		t.Errorf("LCA().Query(5, 2) = %v, %v, want 1, nil", ncd, err)
+	}
+}
+
+func Test_dominators_invalid_root(t *testing.T) {
+	_, err := graph.Dominators(graph.New(), 0)
+	if err != errors.ErrVertexNotFound {
+		t.Errorf("err = %v, want ErrVertexNotFound", err)
+	}
+}
+
+func Test_dominators_match_brute_force(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for run := 0; run < 100; run++ {
+		n := 1 + r.Intn(12)
+		g := graph.New(graph.Vertices(n))
+		for i := 0; i < n*2; i++ {
+			g.Edge(r.Intn(n), r.Intn(n))
+		}
+
+		d, err := graph.Dominators(g, 0)
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+
+		base := reachable(g, 0, -1)
+		for a := 0; a < n; a++ {
+			without := reachable(g, 0, a)
+			for b := 0; b < n; b++ {
+				want := base[b] && (a == b || !without[b])
+				if got := d.Dominates(a, b); got != want {
+					t.Fatalf("run %v: Dominates(%v, %v) = %v, want %v", run, a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+// reachable returns the vertices reachable from root without passing through skip.
+func reachable(g graph.Graph, root, skip int) []bool {
+	seen := make([]bool, g.Vertices())
+	if root == skip {
+		return seen
+	}
+	seen[root] = true
+	stack := []int{root}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		adj, _ := g.Adjacent(v)
+		for _, w := range adj {
+			if !seen[w] && w != skip {
+				seen[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return seen
+}
+
+func Test_lca(t *testing.T) {
+	g := graph.New(graph.Vertices(8), graph.Upward(map[int][]int{
+		0: {1, 2},
+		1: {3, 4},
+		2: {5},
+		4: {6, 7},
+	}))
+
+	l, err := graph.NewLCA(g, 0)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	tt := []struct {
+		v, w int
+		lca  int
+	}{
+		{6, 7, 4},
+		{6, 3, 1},
+		{7, 5, 0},
+		{4, 6, 4},
+		{2, 2, 2},
+		{0, 5, 0},
+	}
+
+	for _, tc := range tt {
+		got, err := l.Query(tc.v, tc.w)
+		if err != nil || got != tc.lca {
+			t.Errorf("Query(%v, %v) = %v, %v, want %v, nil", tc.v, tc.w, got, err, tc.lca)
+		}
+	}
+
+	if _, err := l.Query(0, 8); err != errors.ErrVertexNotFound {
+		t.Errorf("Query(0, 8) err = %v, want ErrVertexNotFound", err)
+	}
+}
+
+func Test_lca_rejects_non_tree(t *testing.T) {
+	g := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2}, 1: {3}, 2: {3}}))
+
+	_, err := graph.NewLCA(g, 0)
+	if err != errors.ErrNotTree {
+		t.Errorf("err = %v, want ErrNotTree", err)
+	}
+}
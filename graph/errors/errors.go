@@ -1,14 +1,81 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 var (
 	// ErrCannotAddVertices is emitted when an invalid edge is specified in the creation of a new vertex.
 	ErrCannotAddVertices = errors.New("graph: cannot add vertices with invalid edges")
 	// ErrCannotAddEdge is emitted when one or more of the vertices in an edge are invalid/non-existent.
 	ErrCannotAddEdge = errors.New("graph: cannot add edge with invalid vertices")
+	// ErrSelfLoop is emitted when a strict graph is given an edge from a vertex to itself.
+	ErrSelfLoop = errors.New("graph: self-loop not permitted")
+	// ErrDuplicateEdge is emitted when a strict graph is given an edge that already exists.
+	ErrDuplicateEdge = errors.New("graph: duplicate edge not permitted")
+	// ErrEdgeNotFound is emitted when an operation requires an edge between vertices that are not adjacent.
+	ErrEdgeNotFound = errors.New("graph: edge not found")
 	// ErrVertexNotFound is emitted when a vertex does not exist and therefore has no edge set.
 	ErrVertexNotFound = errors.New("graph: vertex not found")
+	// ErrCyclicEdge is emitted when adding an edge would introduce a cycle into an acyclic graph.
+	ErrCyclicEdge = errors.New("graph: edge would introduce a cycle")
+	// ErrNoDominator is emitted when the root or an unreachable vertex is queried for its immediate dominator.
+	ErrNoDominator = errors.New("graph: vertex has no immediate dominator")
+	// ErrNotTree is emitted when a graph has a vertex with more than one parent.
+	ErrNotTree = errors.New("graph: graph is not a rooted tree")
+	// ErrNoEulerianPath is emitted when no path exists that traverses every edge exactly once.
+	ErrNoEulerianPath = errors.New("graph: no eulerian path")
+	// ErrNoEulerianCircuit is emitted when no circuit exists that traverses every edge exactly once.
+	ErrNoEulerianCircuit = errors.New("graph: no eulerian circuit")
+	// ErrNoHamiltonianPath is emitted when no path exists that visits every vertex exactly once.
+	ErrNoHamiltonianPath = errors.New("graph: no hamiltonian path")
+	// ErrGraphTooLarge is emitted when an exponential algorithm is requested for a graph exceeding its bound.
+	ErrGraphTooLarge = errors.New("graph: too many vertices for algorithm")
+	// ErrNotIsomorphic is emitted when no vertex mapping exists between two graphs.
+	ErrNotIsomorphic = errors.New("graph: graphs are not isomorphic")
+	// ErrReadOnly is emitted when a read-only graph is modified.
+	ErrReadOnly = errors.New("graph: graph is read-only")
+	// ErrInvalidOrder is emitted when a vertex ordering does not contain every vertex exactly once.
+	ErrInvalidOrder = errors.New("graph: order must contain every vertex exactly once")
 	// ErrNoVertices is emitted when the graph cannot carry out a calculation due to an absence of vertices.
 	ErrNoVertices = errors.New("graph: no vertices in graph")
 )
+
+// VertexError annotates an error with the vertex ids that caused it. It unwraps to the annotated error so it can
+// be matched with errors.Is.
+type VertexError struct {
+	Err error
+	IDs []int
+}
+
+// Vertices annotates err with the offending vertex ids.
+func Vertices(err error, ids ...int) error {
+	return &VertexError{Err: err, IDs: ids}
+}
+
+func (e *VertexError) Error() string {
+	ids := make([]string, 0, len(e.IDs))
+	for _, id := range e.IDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return fmt.Sprintf("%v: %s", e.Err, strings.Join(ids, ", "))
+}
+
+// Unwrap returns the annotated error.
+func (e *VertexError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether any error in err's chain matches target. It and As save callers importing this package
+// from also importing the standard errors package under another name.
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in err's chain that matches target, and if so, sets target to that error value.
+func As(err error, target interface{}) bool {
+	return errors.As(err, target)
+}
@@ -0,0 +1,171 @@
+package graph
+
+import "github.com/nfisher/goalgo/graph/errors"
+
+// EulerianPath returns a sequence of vertices that traverses every directed edge exactly once using
+// Hierholzer's algorithm.
+func EulerianPath(g Graph) ([]int, error) {
+	return directedEuler(g, false)
+}
+
+// EulerianCircuit returns a sequence of vertices that traverses every directed edge exactly once and ends where
+// it started.
+func EulerianCircuit(g Graph) ([]int, error) {
+	return directedEuler(g, true)
+}
+
+// UndirectedEulerianPath returns a sequence of vertices that traverses every undirected edge exactly once.
+func UndirectedEulerianPath(g Graph) ([]int, error) {
+	return undirectedEuler(g, false)
+}
+
+// UndirectedEulerianCircuit returns a sequence of vertices that traverses every undirected edge exactly once and
+// ends where it started.
+func UndirectedEulerianCircuit(g Graph) ([]int, error) {
+	return undirectedEuler(g, true)
+}
+
+func directedEuler(g Graph, circuit bool) ([]int, error) {
+	noPath := errors.ErrNoEulerianPath
+	if circuit {
+		noPath = errors.ErrNoEulerianCircuit
+	}
+
+	n := g.Vertices()
+	adjacent := make([][]int, n)
+	balance := make([]int, n)
+	for v := range adjacent {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		adjacent[v] = adj
+		balance[v] += len(adj)
+		for _, w := range adj {
+			balance[w]--
+		}
+	}
+
+	if g.Edges() == 0 {
+		return []int{}, nil
+	}
+
+	start := -1
+	var starts, ends int
+	for v, b := range balance {
+		switch {
+		case b == 1:
+			starts++
+			start = v
+		case b == -1:
+			ends++
+		case b != 0:
+			return nil, noPath
+		}
+	}
+	if starts > 1 || ends > 1 || starts != ends || (circuit && starts != 0) {
+		return nil, noPath
+	}
+	if start == -1 {
+		for v := range adjacent {
+			if len(adjacent[v]) > 0 {
+				start = v
+				break
+			}
+		}
+	}
+
+	next := make([]int, n)
+	path := make([]int, 0, g.Edges()+1)
+	stack := []int{start}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		if next[v] < len(adjacent[v]) {
+			stack = append(stack, adjacent[v][next[v]])
+			next[v]++
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		path = append(path, v)
+	}
+
+	// edges in another component are never reached from the start.
+	if len(path) != g.Edges()+1 {
+		return nil, noPath
+	}
+
+	return reverse(path), nil
+}
+
+func undirectedEuler(g Graph, circuit bool) ([]int, error) {
+	noPath := errors.ErrNoEulerianPath
+	if circuit {
+		noPath = errors.ErrNoEulerianCircuit
+	}
+
+	incident, edges, err := undirected(g)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(edges) == 0 {
+		return []int{}, nil
+	}
+
+	start := -1
+	var odd int
+	for v, arcs := range incident {
+		degree := len(arcs)
+		for _, a := range arcs {
+			if a.to == v {
+				// a self-loop has a single arc but contributes two to the degree.
+				degree++
+			}
+		}
+		if degree%2 == 1 {
+			odd++
+			if start == -1 {
+				start = v
+			}
+		}
+	}
+	if odd > 2 || (circuit && odd != 0) {
+		return nil, noPath
+	}
+	if start == -1 {
+		start = edges[0].V
+	}
+
+	used := make([]bool, len(edges))
+	next := make([]int, len(incident))
+	path := make([]int, 0, len(edges)+1)
+	stack := []int{start}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		for next[v] < len(incident[v]) && used[incident[v][next[v]].id] {
+			next[v]++
+		}
+		if next[v] < len(incident[v]) {
+			a := incident[v][next[v]]
+			used[a.id] = true
+			stack = append(stack, a.to)
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		path = append(path, v)
+	}
+
+	if len(path) != len(edges)+1 {
+		return nil, noPath
+	}
+
+	return reverse(path), nil
+}
+
+// reverse reverses the slice in place and returns it.
+func reverse(vs []int) []int {
+	for i, j := 0, len(vs)-1; i < j; i, j = i+1, j-1 {
+		vs[i], vs[j] = vs[j], vs[i]
+	}
+	return vs
+}
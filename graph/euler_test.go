@@ -0,0 +1,120 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+func Test_eulerian_path_when(t *testing.T) {
+	tt := map[string]struct {
+		g       graph.Graph
+		circuit bool
+		err     error
+		cErr    error
+	}{
+		"no edges":        {graph.New(graph.Vertices(2)), true, nil, nil},
+		"chain":           {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}})), false, nil, errors.ErrNoEulerianCircuit},
+		"triangle":        {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}, 2: {0}})), true, nil, nil},
+		"two loops":       {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1, 2}, 1: {0}, 2: {0}})), true, nil, nil},
+		"self loop":       {graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {0, 1}})), false, nil, errors.ErrNoEulerianCircuit},
+		"two sources":     {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {2}, 1: {2}})), false, errors.ErrNoEulerianPath, errors.ErrNoEulerianCircuit},
+		"disconnected":    {graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1}, 1: {0}, 2: {3}, 3: {2}})), false, errors.ErrNoEulerianPath, errors.ErrNoEulerianCircuit},
+		"unbalanced star": {graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2, 3}})), false, errors.ErrNoEulerianPath, errors.ErrNoEulerianCircuit},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			path, err := graph.EulerianPath(tc.g)
+			if err != tc.err {
+				t.Fatalf("EulerianPath() err = %v, want %v", err, tc.err)
+			}
+			if err == nil {
+				assertEulerian(t, tc.g, path, false)
+			}
+
+			circuit, err := graph.EulerianCircuit(tc.g)
+			if err != tc.cErr {
+				t.Fatalf("EulerianCircuit() err = %v, want %v", err, tc.cErr)
+			}
+			if err == nil {
+				assertEulerian(t, tc.g, circuit, false)
+				if len(circuit) > 0 && circuit[0] != circuit[len(circuit)-1] {
+					t.Errorf("circuit %v does not return to start", circuit)
+				}
+			}
+		})
+	}
+}
+
+func Test_undirected_eulerian_path_when(t *testing.T) {
+	tt := map[string]struct {
+		g    graph.Graph
+		err  error
+		cErr error
+	}{
+		"chain":        {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 2: {1}})), nil, errors.ErrNoEulerianCircuit},
+		"triangle":     {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1, 2}, 1: {2}})), nil, nil},
+		"house":        {graph.New(graph.Vertices(5), graph.Upward(map[int][]int{0: {1, 2}, 1: {2, 3}, 2: {4}, 3: {4}})), nil, errors.ErrNoEulerianCircuit},
+		"self loop":    {graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {0, 1}})), nil, errors.ErrNoEulerianCircuit},
+		"star":         {graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2, 3}})), errors.ErrNoEulerianPath, errors.ErrNoEulerianCircuit},
+		"disconnected": {graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1}, 2: {3}})), errors.ErrNoEulerianPath, errors.ErrNoEulerianCircuit},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			path, err := graph.UndirectedEulerianPath(tc.g)
+			if err != tc.err {
+				t.Fatalf("UndirectedEulerianPath() err = %v, want %v", err, tc.err)
+			}
+			if err == nil {
+				assertEulerian(t, tc.g, path, true)
+			}
+
+			circuit, err := graph.UndirectedEulerianCircuit(tc.g)
+			if err != tc.cErr {
+				t.Fatalf("UndirectedEulerianCircuit() err = %v, want %v", err, tc.cErr)
+			}
+			if err == nil {
+				assertEulerian(t, tc.g, circuit, true)
+				if circuit[0] != circuit[len(circuit)-1] {
+					t.Errorf("circuit %v does not return to start", circuit)
+				}
+			}
+		})
+	}
+}
+
+// assertEulerian checks that consecutive vertices in path consume every edge of g exactly once.
+func assertEulerian(t *testing.T, g graph.Graph, path []int, undirected bool) {
+	t.Helper()
+	remaining := make(map[graph.Edge]int)
+	for v := 0; v < g.Vertices(); v++ {
+		adj, _ := g.Adjacent(v)
+		for _, w := range adj {
+			remaining[graph.Edge{V: v, W: w}]++
+		}
+	}
+
+	if g.Edges() == 0 {
+		if len(path) != 0 {
+			t.Fatalf("path = %v, want []", path)
+		}
+		return
+	}
+	if len(path) != g.Edges()+1 {
+		t.Fatalf("len(path) = %v, want %v", len(path), g.Edges()+1)
+	}
+
+	for i := 1; i < len(path); i++ {
+		e := graph.Edge{V: path[i-1], W: path[i]}
+		if remaining[e] == 0 && undirected {
+			e = graph.Edge{V: e.W, W: e.V}
+		}
+		if remaining[e] == 0 {
+			t.Fatalf("path %v uses %v→%v more often than present", path, path[i-1], path[i])
+		}
+		remaining[e]--
+	}
+}
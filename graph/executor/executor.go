@@ -0,0 +1,185 @@
+// Package executor runs a task for every vertex of a DAG on a bounded worker pool, starting each task as soon as
+// the tasks it depends on have completed. An edge v→w means the task for v must complete before w is started.
+package executor
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	"github.com/nfisher/goalgo/graph"
+)
+
+// ErrDependencyFailed is recorded against a task that was not run because one of its dependencies failed.
+var ErrDependencyFailed = errors.New("executor: dependency failed")
+
+// Task is the unit of work executed for vertex v.
+type Task func(ctx context.Context, v int) (interface{}, error)
+
+// Result is the outcome of the task for a single vertex.
+type Result struct {
+	Vertex int
+	Value  interface{}
+	Err    error
+}
+
+// Option is an executor configuration modifier.
+type Option func(*config)
+
+type config struct {
+	workers         int
+	continueOnError bool
+}
+
+// Workers bounds the number of tasks that will run concurrently, defaults to GOMAXPROCS.
+func Workers(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.workers = n
+		}
+	}
+}
+
+// ContinueOnError keeps running tasks that do not depend on a failed task. By default the first failure
+// cancels the run.
+func ContinueOnError() Option {
+	return func(c *config) {
+		c.continueOnError = true
+	}
+}
+
+const (
+	pending = iota
+	running
+	finished
+)
+
+// Run executes task for every vertex in g and returns the results indexed by vertex along with the first error
+// encountered. Tasks that are never started record ErrDependencyFailed or the context error as their result.
+func Run(ctx context.Context, g graph.Graph, task Task, opts ...Option) ([]Result, error) {
+	cfg := config{workers: runtime.GOMAXPROCS(0)}
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	_, err := graph.LexicographicalSort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := g.Vertices()
+	results := make([]Result, n)
+	adjacent := make([][]int, n)
+	in := make([]int, n)
+	for v := range adjacent {
+		results[v].Vertex = v
+		adjacent[v], err = g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range adjacent[v] {
+			in[w]++
+		}
+	}
+
+	var ready []int
+	for v, d := range in {
+		if d == 0 {
+			ready = append(ready, v)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan int)
+	done := make(chan Result)
+	defer close(jobs)
+	for i := 0; i < cfg.workers; i++ {
+		go func() {
+			for v := range jobs {
+				value, err := task(ctx, v)
+				done <- Result{Vertex: v, Value: value, Err: err}
+			}
+		}()
+	}
+
+	state := make([]int8, n)
+	var firstErr error
+	var active, complete int
+
+	// skip marks every pending descendant of v as failed, none of them can have started.
+	var skip func(v int)
+	skip = func(v int) {
+		for _, w := range adjacent[v] {
+			if state[w] != pending {
+				continue
+			}
+			state[w] = finished
+			results[w].Err = ErrDependencyFailed
+			complete++
+			skip(w)
+		}
+	}
+
+	ctxDone := ctx.Done()
+	for complete < n {
+		if ctx.Err() != nil && active == 0 {
+			break
+		}
+
+		var send chan int
+		var next int
+		if len(ready) > 0 && ctx.Err() == nil {
+			send = jobs
+			next = ready[0]
+		}
+
+		select {
+		case send <- next:
+			ready = ready[1:]
+			state[next] = running
+			active++
+
+		case r := <-done:
+			active--
+			complete++
+			state[r.Vertex] = finished
+			results[r.Vertex] = r
+
+			if r.Err != nil {
+				if firstErr == nil {
+					firstErr = r.Err
+				}
+				if !cfg.continueOnError {
+					cancel()
+				}
+				skip(r.Vertex)
+				continue
+			}
+
+			for _, w := range adjacent[r.Vertex] {
+				in[w]--
+				if in[w] == 0 && state[w] == pending {
+					ready = append(ready, w)
+				}
+			}
+
+		case <-ctxDone:
+			ctxDone = nil
+		}
+	}
+
+	if complete < n {
+		for v := range state {
+			if state[v] == pending {
+				results[v].Err = ctx.Err()
+			}
+		}
+		if firstErr == nil {
+			firstErr = ctx.Err()
+		}
+	}
+
+	return results, firstErr
+}
@@ -0,0 +1,184 @@
+package executor_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/executor"
+)
+
+var errBoom = errors.New("boom")
+
+func exampleGraph() graph.Graph {
+	return graph.New(
+		graph.Vertices(8),
+		graph.Upward(map[int][]int{
+			0: {3},
+			1: {3, 4},
+			2: {4, 7},
+			3: {5, 6, 7},
+			4: {6},
+		}),
+	)
+}
+
+func Test_run_should_respect_dependencies(t *testing.T) {
+	g := exampleGraph()
+
+	var mu sync.Mutex
+	completed := make(map[int]bool)
+	results, err := executor.Run(context.Background(), g, func(ctx context.Context, v int) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		for u := 0; u < g.Vertices(); u++ {
+			adj, _ := g.Adjacent(u)
+			for _, w := range adj {
+				if w == v && !completed[u] {
+					t.Errorf("task %v started before dependency %v", v, u)
+				}
+			}
+		}
+		completed[v] = true
+		return v * 10, nil
+	}, executor.Workers(3))
+
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	for v, r := range results {
+		if r.Vertex != v || r.Value != v*10 || r.Err != nil {
+			t.Errorf("results[%v] = %+v, want {%v %v <nil>}", v, r, v, v*10)
+		}
+	}
+}
+
+func Test_run_should_bound_concurrency(t *testing.T) {
+	var current, peak int32
+	_, err := executor.Run(context.Background(), graph.New(graph.Vertices(16)), func(ctx context.Context, v int) (interface{}, error) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&current, -1)
+		return nil, nil
+	}, executor.Workers(2))
+
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if peak > 2 {
+		t.Errorf("peak concurrency = %v, want <= 2", peak)
+	}
+}
+
+func Test_run_fail_fast(t *testing.T) {
+	g := graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}}))
+
+	var ran int32
+	results, err := executor.Run(context.Background(), g, func(ctx context.Context, v int) (interface{}, error) {
+		atomic.AddInt32(&ran, 1)
+		if v == 0 {
+			return nil, errBoom
+		}
+		return nil, nil
+	})
+
+	if err != errBoom {
+		t.Errorf("err = %v, want errBoom", err)
+	}
+	if ran != 1 {
+		t.Errorf("ran = %v, want 1", ran)
+	}
+	if results[0].Err != errBoom {
+		t.Errorf("results[0].Err = %v, want errBoom", results[0].Err)
+	}
+	for _, v := range []int{1, 2} {
+		if results[v].Err != executor.ErrDependencyFailed {
+			t.Errorf("results[%v].Err = %v, want ErrDependencyFailed", v, results[v].Err)
+		}
+	}
+}
+
+func Test_run_fail_fast_should_not_start_independent_tasks(t *testing.T) {
+	g := graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}}), graph.Vertices(1))
+
+	results, err := executor.Run(context.Background(), g, func(ctx context.Context, v int) (interface{}, error) {
+		if v == 0 {
+			return nil, errBoom
+		}
+		return nil, nil
+	}, executor.Workers(1))
+
+	if err != errBoom {
+		t.Errorf("err = %v, want errBoom", err)
+	}
+	if results[2].Err != context.Canceled {
+		t.Errorf("results[2].Err = %v, want context.Canceled", results[2].Err)
+	}
+}
+
+func Test_run_continue_on_error(t *testing.T) {
+	results, err := executor.Run(context.Background(), exampleGraph(), func(ctx context.Context, v int) (interface{}, error) {
+		if v == 4 {
+			return nil, errBoom
+		}
+		return v, nil
+	}, executor.ContinueOnError())
+
+	if err != errBoom {
+		t.Errorf("err = %v, want errBoom", err)
+	}
+
+	want := map[int]error{4: errBoom, 6: executor.ErrDependencyFailed}
+	for v, r := range results {
+		if r.Err != want[v] {
+			t.Errorf("results[%v].Err = %v, want %v", v, r.Err, want[v])
+		}
+		if want[v] == nil && r.Value != v {
+			t.Errorf("results[%v].Value = %v, want %v", v, r.Value, v)
+		}
+	}
+}
+
+func Test_run_should_stop_on_context_cancellation(t *testing.T) {
+	g := graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}}))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	results, err := executor.Run(ctx, g, func(ctx context.Context, v int) (interface{}, error) {
+		if v == 0 {
+			cancel()
+		}
+		return nil, nil
+	})
+
+	if err != context.Canceled {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if results[0].Err != nil {
+		t.Errorf("results[0].Err = %v, want nil", results[0].Err)
+	}
+	if results[2].Err != context.Canceled {
+		t.Errorf("results[2].Err = %v, want context.Canceled", results[2].Err)
+	}
+}
+
+func Test_run_on_cyclic_graph_should_return_error(t *testing.T) {
+	g := graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}, 1: {0}}))
+
+	_, err := executor.Run(context.Background(), g, func(context.Context, int) (interface{}, error) {
+		return nil, nil
+	})
+	if err != graph.ErrCyclicGraph {
+		t.Errorf("err = %v, want ErrCyclicGraph", err)
+	}
+}
@@ -10,6 +10,16 @@ func Directed() *adjacency.List {
 	return &adjacency.List{}
 }
 
+// Strict returns a new directed graph that rejects self-loops and duplicate edges.
+func Strict() *adjacency.List {
+	return adjacency.NewStrict()
+}
+
+// Concurrent returns a new directed graph that is safe for concurrent use.
+func Concurrent() *adjacency.Sync {
+	return &adjacency.Sync{}
+}
+
 // Graph interface for various forms of graphs.
 type Graph interface {
 	Edge(v, w int) error
@@ -19,6 +29,12 @@ type Graph interface {
 	Edges() int
 }
 
+// Edge is a pair of vertices joined by an edge. Algorithms that treat the graph as undirected consider each
+// stored edge v→w to be the undirected edge {v, w}, so an undirected edge should only be added once.
+type Edge struct {
+	V, W int
+}
+
 // Average returns the average degree of the list.
 func Average(g Graph) (float64, error) {
 	if g.Vertices() == 0 {
@@ -0,0 +1,83 @@
+package graph
+
+import "github.com/nfisher/goalgo/graph/errors"
+
+// MaxHamiltonianVertices is the largest graph HamiltonianPath will search, the search is O(2^n * n^2).
+const MaxHamiltonianVertices = 20
+
+// HamiltonianPath returns a sequence of vertices that follows the directed edges and visits every vertex exactly
+// once. It uses the Held-Karp dynamic programme over vertex subsets so is limited to MaxHamiltonianVertices.
+func HamiltonianPath(g Graph) ([]int, error) {
+	return hamiltonian(g, false)
+}
+
+// UndirectedHamiltonianPath returns a sequence of vertices that visits every vertex exactly once treating each
+// edge as undirected.
+func UndirectedHamiltonianPath(g Graph) ([]int, error) {
+	return hamiltonian(g, true)
+}
+
+func hamiltonian(g Graph, undirected bool) ([]int, error) {
+	n := g.Vertices()
+	if n == 0 {
+		return nil, errors.ErrNoVertices
+	}
+	if n > MaxHamiltonianVertices {
+		return nil, errors.ErrGraphTooLarge
+	}
+
+	// pred[w] has bit v set when the edge v→w exists.
+	pred := make([]uint32, n)
+	for v := 0; v < n; v++ {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range adj {
+			if v == w {
+				continue
+			}
+			pred[w] |= 1 << uint(v)
+			if undirected {
+				pred[v] |= 1 << uint(w)
+			}
+		}
+	}
+
+	// ends[mask] has bit v set when a path visiting exactly the vertices in mask can end at v.
+	full := uint32(1)<<uint(n) - 1
+	ends := make([]uint32, full+1)
+	for v := 0; v < n; v++ {
+		ends[1<<uint(v)] = 1 << uint(v)
+	}
+	for mask := uint32(1); mask <= full; mask++ {
+		for v := 0; v < n; v++ {
+			bit := uint32(1) << uint(v)
+			if mask&bit == 0 || mask == bit {
+				continue
+			}
+			if ends[mask^bit]&pred[v] != 0 {
+				ends[mask] |= bit
+			}
+		}
+	}
+
+	if ends[full] == 0 {
+		return nil, errors.ErrNoHamiltonianPath
+	}
+
+	path := make([]int, 0, n)
+	mask := full
+	candidates := ends[full]
+	for mask != 0 {
+		var v int
+		for candidates&(1<<uint(v)) == 0 {
+			v++
+		}
+		path = append(path, v)
+		mask ^= 1 << uint(v)
+		candidates = ends[mask] & pred[v]
+	}
+
+	return reverse(path), nil
+}
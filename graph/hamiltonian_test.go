@@ -0,0 +1,129 @@
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+func Test_hamiltonian_path_when(t *testing.T) {
+	tt := map[string]struct {
+		g   graph.Graph
+		err error
+	}{
+		"empty graph":      {graph.New(), errors.ErrNoVertices},
+		"single node":      {graph.New(graph.Vertices(1)), nil},
+		"three node chain": {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{1: {0}, 2: {1}})), nil},
+		"example graph":    {exampleGraph(), errors.ErrNoHamiltonianPath},
+		"tournament":       {graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2}, 1: {2, 3}, 3: {0, 2}})), nil},
+		"too large":        {graph.New(graph.Vertices(graph.MaxHamiltonianVertices + 1)), errors.ErrGraphTooLarge},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			path, err := graph.HamiltonianPath(tc.g)
+			if err != tc.err {
+				t.Fatalf("err = %v, want %v", err, tc.err)
+			}
+			if err == nil {
+				assertHamiltonian(t, tc.g, path, false)
+			}
+		})
+	}
+}
+
+func Test_undirected_hamiltonian_path(t *testing.T) {
+	star := graph.New(graph.Vertices(3), graph.Upward(map[int][]int{1: {0, 2}}))
+
+	path, err := graph.UndirectedHamiltonianPath(star)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	assertHamiltonian(t, star, path, true)
+
+	if _, err := graph.HamiltonianPath(star); err != errors.ErrNoHamiltonianPath {
+		t.Errorf("directed err = %v, want ErrNoHamiltonianPath", err)
+	}
+}
+
+func Test_hamiltonian_path_matches_brute_force(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+
+	for run := 0; run < 100; run++ {
+		n := 1 + r.Intn(6)
+		g := graph.New(graph.Vertices(n))
+		for i := 0; i < n*2; i++ {
+			g.Edge(r.Intn(n), r.Intn(n))
+		}
+
+		path, err := graph.HamiltonianPath(g)
+		exists := bruteHamiltonian(g)
+		if exists != (err == nil) {
+			t.Fatalf("run %v: err = %v, want exists = %v", run, err, exists)
+		}
+		if err == nil {
+			assertHamiltonian(t, g, path, false)
+		}
+	}
+}
+
+func assertHamiltonian(t *testing.T, g graph.Graph, path []int, undirected bool) {
+	t.Helper()
+	if len(path) != g.Vertices() {
+		t.Fatalf("len(path) = %v, want %v", len(path), g.Vertices())
+	}
+
+	seen := make(map[int]bool)
+	for i, v := range path {
+		if seen[v] {
+			t.Fatalf("path %v visits %v twice", path, v)
+		}
+		seen[v] = true
+		if i > 0 && !hasEdge(g, path[i-1], v) && !(undirected && hasEdge(g, v, path[i-1])) {
+			t.Fatalf("path %v uses missing edge %v→%v", path, path[i-1], v)
+		}
+	}
+}
+
+func hasEdge(g graph.Graph, v, w int) bool {
+	adj, _ := g.Adjacent(v)
+	for _, x := range adj {
+		if x == w {
+			return true
+		}
+	}
+	return false
+}
+
+// bruteHamiltonian checks every permutation for a hamiltonian path.
+func bruteHamiltonian(g graph.Graph) bool {
+	n := g.Vertices()
+	used := make([]bool, n)
+	var extend func(v, depth int) bool
+	extend = func(v, depth int) bool {
+		if depth == n {
+			return true
+		}
+		for w := 0; w < n; w++ {
+			if !used[w] && hasEdge(g, v, w) {
+				used[w] = true
+				if extend(w, depth+1) {
+					return true
+				}
+				used[w] = false
+			}
+		}
+		return false
+	}
+
+	for v := 0; v < n; v++ {
+		used[v] = true
+		if extend(v, 1) {
+			return true
+		}
+		used[v] = false
+	}
+	return false
+}
@@ -0,0 +1,324 @@
+package graph
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// Isomorphism returns a mapping from every vertex in a to a vertex in b that preserves all edges, including their
+// direction and multiplicity, using the VF2 algorithm. It returns ErrNotIsomorphic if no mapping exists.
+func Isomorphism(a, b Graph) ([]int, error) {
+	if a.Vertices() != b.Vertices() || a.Edges() != b.Edges() {
+		return nil, errors.ErrNotIsomorphic
+	}
+
+	ha, err := WLHash(a, a.Vertices())
+	if err != nil {
+		return nil, err
+	}
+	hb, err := WLHash(b, b.Vertices())
+	if err != nil {
+		return nil, err
+	}
+	if ha != hb {
+		return nil, errors.ErrNotIsomorphic
+	}
+
+	return match(b, a, false)
+}
+
+// SubgraphIsomorphism returns a mapping from every vertex in sub to a distinct vertex in g such that sub is
+// isomorphic to the subgraph of g induced by the mapped vertices. It returns ErrNotIsomorphic if no mapping exists.
+func SubgraphIsomorphism(g, sub Graph) ([]int, error) {
+	if sub.Vertices() > g.Vertices() {
+		return nil, errors.ErrNotIsomorphic
+	}
+	return match(g, sub, true)
+}
+
+// WLHash returns a hash of the graph structure using the given number of Weisfeiler-Lehman colour refinement
+// rounds. Isomorphic graphs always share a hash so it can be used to bucket graphs before an exact check, but
+// graphs sharing a hash are not necessarily isomorphic.
+func WLHash(g Graph, iterations int) (uint64, error) {
+	m, err := newMultigraph(g)
+	if err != nil {
+		return 0, err
+	}
+
+	labels := make([]uint64, m.n)
+	for v := range labels {
+		labels[v] = hashOf(uint64(len(m.out[v])), uint64(len(m.in[v])), uint64(m.out[v][v]))
+	}
+
+	for i := 0; i < iterations; i++ {
+		next := make([]uint64, m.n)
+		for v := range next {
+			out := neighbourLabels(m.out[v], labels)
+			in := neighbourLabels(m.in[v], labels)
+			next[v] = hashOf(append(append(append([]uint64{labels[v]}, out...), 0), in...)...)
+		}
+		labels = next
+	}
+
+	sort.Slice(labels, func(i, j int) bool { return labels[i] < labels[j] })
+	return hashOf(append([]uint64{uint64(m.n), uint64(g.Edges())}, labels...)...), nil
+}
+
+// neighbourLabels returns the sorted labels of the neighbours repeated by edge multiplicity.
+func neighbourLabels(neighbours map[int]int, labels []uint64) []uint64 {
+	var ls []uint64
+	for w, c := range neighbours {
+		for i := 0; i < c; i++ {
+			ls = append(ls, labels[w])
+		}
+	}
+	sort.Slice(ls, func(i, j int) bool { return ls[i] < ls[j] })
+	return ls
+}
+
+func hashOf(values ...uint64) uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint64(b[:], v)
+		h.Write(b[:])
+	}
+	return h.Sum64()
+}
+
+// multigraph counts the edges between each pair of vertices in both directions.
+type multigraph struct {
+	n   int
+	out []map[int]int
+	in  []map[int]int
+}
+
+func newMultigraph(g Graph) (*multigraph, error) {
+	n := g.Vertices()
+	m := &multigraph{
+		n:   n,
+		out: make([]map[int]int, n),
+		in:  make([]map[int]int, n),
+	}
+	for v := 0; v < n; v++ {
+		m.out[v] = make(map[int]int)
+		m.in[v] = make(map[int]int)
+	}
+	for v := 0; v < n; v++ {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range adj {
+			m.out[v][w]++
+			m.in[w][v]++
+		}
+	}
+	return m, nil
+}
+
+// vf2 is the matching state between a target graph 1 and a pattern graph 2. The terminal arrays record the depth
+// at which a vertex became a successor or predecessor of the partial mapping, 0 when it is neither.
+type vf2 struct {
+	g1, g2    *multigraph
+	subgraph  bool
+	core1     []int
+	core2     []int
+	in1, out1 []int
+	in2, out2 []int
+	depth     int
+}
+
+// match returns a mapping from the pattern vertices to the target vertices.
+func match(target, pattern Graph, subgraph bool) ([]int, error) {
+	g1, err := newMultigraph(target)
+	if err != nil {
+		return nil, err
+	}
+	g2, err := newMultigraph(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &vf2{
+		g1:       g1,
+		g2:       g2,
+		subgraph: subgraph,
+		core1:    filled(g1.n, -1),
+		core2:    filled(g2.n, -1),
+		in1:      make([]int, g1.n),
+		out1:     make([]int, g1.n),
+		in2:      make([]int, g2.n),
+		out2:     make([]int, g2.n),
+	}
+
+	if !s.search() {
+		return nil, errors.ErrNotIsomorphic
+	}
+	return s.core2, nil
+}
+
+func filled(n, v int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = v
+	}
+	return s
+}
+
+func (s *vf2) search() bool {
+	if s.depth == s.g2.n {
+		return true
+	}
+
+	p, targets := s.candidates()
+	for _, t := range targets {
+		if !s.feasible(p, t) {
+			continue
+		}
+		s.add(p, t)
+		if s.search() {
+			return true
+		}
+		s.remove(p, t)
+	}
+	return false
+}
+
+// candidates returns the next pattern vertex to map and the target vertices it may be paired with, preferring
+// vertices adjacent to the existing mapping.
+func (s *vf2) candidates() (int, []int) {
+	terminal := func(core, depth []int) []int {
+		var vs []int
+		for v := range core {
+			if core[v] == -1 && depth[v] > 0 {
+				vs = append(vs, v)
+			}
+		}
+		return vs
+	}
+
+	for _, pair := range [][2][]int{{s.out1, s.out2}, {s.in1, s.in2}} {
+		t1, t2 := terminal(s.core1, pair[0]), terminal(s.core2, pair[1])
+		if len(t2) > 0 {
+			return t2[0], t1
+		}
+		if len(t1) > 0 && !s.subgraph {
+			return -1, nil
+		}
+	}
+
+	var p int
+	for s.core2[p] != -1 {
+		p++
+	}
+	var targets []int
+	for t := range s.core1 {
+		if s.core1[t] == -1 {
+			targets = append(targets, t)
+		}
+	}
+	return p, targets
+}
+
+// feasible checks that pairing p with t keeps the mapping consistent and that enough unmapped neighbours remain.
+func (s *vf2) feasible(p, t int) bool {
+	if s.g2.out[p][p] != s.g1.out[t][t] {
+		return false
+	}
+
+	type counts struct{ in, out, other int }
+	var c1, c2 counts
+
+	consistent := func(from, to map[int]int, core []int, inT, outT []int, c *counts) bool {
+		for v, n := range from {
+			if core[v] != -1 {
+				if to[core[v]] != n {
+					return false
+				}
+				continue
+			}
+			if inT[v] > 0 {
+				c.in++
+			}
+			if outT[v] > 0 {
+				c.out++
+			}
+			if inT[v] == 0 && outT[v] == 0 {
+				c.other++
+			}
+		}
+		return true
+	}
+
+	if !consistent(s.g2.out[p], s.g1.out[t], s.core2, s.in2, s.out2, &c2) ||
+		!consistent(s.g2.in[p], s.g1.in[t], s.core2, s.in2, s.out2, &c2) ||
+		!consistent(s.g1.out[t], s.g2.out[p], s.core1, s.in1, s.out1, &c1) ||
+		!consistent(s.g1.in[t], s.g2.in[p], s.core1, s.in1, s.out1, &c1) {
+		return false
+	}
+
+	if s.subgraph {
+		return c1.in >= c2.in && c1.out >= c2.out && c1.other >= c2.other
+	}
+	return c1 == c2
+}
+
+func (s *vf2) add(p, t int) {
+	s.depth++
+	s.core1[t] = p
+	s.core2[p] = t
+	enter(s.g1, t, s.depth, s.in1, s.out1)
+	enter(s.g2, p, s.depth, s.in2, s.out2)
+}
+
+func (s *vf2) remove(p, t int) {
+	leave(s.g1, t, s.depth, s.in1, s.out1)
+	leave(s.g2, p, s.depth, s.in2, s.out2)
+	s.core1[t] = -1
+	s.core2[p] = -1
+	s.depth--
+}
+
+// enter adds v and its neighbours to the terminal sets at the current depth.
+func enter(g *multigraph, v, depth int, in, out []int) {
+	if in[v] == 0 {
+		in[v] = depth
+	}
+	if out[v] == 0 {
+		out[v] = depth
+	}
+	for w := range g.out[v] {
+		if out[w] == 0 {
+			out[w] = depth
+		}
+	}
+	for w := range g.in[v] {
+		if in[w] == 0 {
+			in[w] = depth
+		}
+	}
+}
+
+// leave removes the terminal set entries made at depth by enter.
+func leave(g *multigraph, v, depth int, in, out []int) {
+	if in[v] == depth {
+		in[v] = 0
+	}
+	if out[v] == depth {
+		out[v] = 0
+	}
+	for w := range g.out[v] {
+		if out[w] == depth {
+			out[w] = 0
+		}
+	}
+	for w := range g.in[v] {
+		if in[w] == depth {
+			in[w] = 0
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// permute returns a copy of g with vertex v renamed to perm[v].
+func permute(g graph.Graph, perm []int) graph.Graph {
+	p := graph.New(graph.Vertices(g.Vertices()))
+	for v := 0; v < g.Vertices(); v++ {
+		adj, _ := g.Adjacent(v)
+		for _, w := range adj {
+			p.Edge(perm[v], perm[w])
+		}
+	}
+	return p
+}
+
+func randomGraph(r *rand.Rand, n, edges int) graph.Graph {
+	g := graph.New(graph.Vertices(n))
+	for i := 0; i < edges; i++ {
+		g.Edge(r.Intn(n), r.Intn(n))
+	}
+	return g
+}
+
+func Test_isomorphism_of_permuted_graphs(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+
+	for run := 0; run < 100; run++ {
+		n := 1 + r.Intn(12)
+		a := randomGraph(r, n, r.Intn(n*3))
+		b := permute(a, r.Perm(n))
+
+		mapping, err := graph.Isomorphism(a, b)
+		if err != nil {
+			t.Fatalf("run %v: err = %v, want nil", run, err)
+		}
+		assertInducedMapping(t, b, a, mapping)
+
+		ha, _ := graph.WLHash(a, 3)
+		hb, _ := graph.WLHash(b, 3)
+		if ha != hb {
+			t.Fatalf("run %v: WLHash() = %v and %v, want equal", run, ha, hb)
+		}
+	}
+}
+
+func Test_isomorphism_rejects_when(t *testing.T) {
+	hexagon := graph.New(graph.Vertices(6), graph.Upward(map[int][]int{0: {1}, 1: {2}, 2: {3}, 3: {4}, 4: {5}, 5: {0}}))
+	triangles := graph.New(graph.Vertices(6), graph.Upward(map[int][]int{0: {1}, 1: {2}, 2: {0}, 3: {4}, 4: {5}, 5: {3}}))
+
+	tt := map[string]struct {
+		a, b graph.Graph
+	}{
+		"vertex counts differ": {graph.New(graph.Vertices(1)), graph.New(graph.Vertices(2))},
+		"edge counts differ":   {graph.New(graph.Vertices(2)), graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}}))},
+		"direction differs":    {cycle(3), graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1, 2}, 1: {2}}))},
+		"same degrees":         {hexagon, triangles},
+		"multiplicity":         {graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1, 1}})), graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}, 1: {0}}))},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			_, err := graph.Isomorphism(tc.a, tc.b)
+			if err != errors.ErrNotIsomorphic {
+				t.Errorf("err = %v, want ErrNotIsomorphic", err)
+			}
+		})
+	}
+}
+
+func Test_subgraph_isomorphism_when(t *testing.T) {
+	path := graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}}))
+
+	tt := map[string]struct {
+		g, sub graph.Graph
+		err    error
+	}{
+		"triangle in complete":         {complete(4), complete(3), nil},
+		"path in complete not induced": {complete(4), path, errors.ErrNotIsomorphic},
+		"path in example":              {exampleGraph(), graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}})), nil},
+		"larger pattern":               {complete(2), complete(3), errors.ErrNotIsomorphic},
+		"empty pattern":                {complete(2), graph.New(), nil},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			mapping, err := graph.SubgraphIsomorphism(tc.g, tc.sub)
+			if err != tc.err {
+				t.Fatalf("err = %v, want %v", err, tc.err)
+			}
+			if err == nil {
+				assertInducedMapping(t, tc.g, tc.sub, mapping)
+			}
+		})
+	}
+}
+
+func Test_subgraph_isomorphism_of_induced_subgraphs(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	for run := 0; run < 100; run++ {
+		n := 1 + r.Intn(10)
+		g := randomGraph(r, n, r.Intn(n*2))
+		sub, _, _ := graph.Induced(g, r.Perm(n)[:1+r.Intn(n)])
+		pattern := permute(sub, r.Perm(sub.Vertices()))
+
+		mapping, err := graph.SubgraphIsomorphism(g, pattern)
+		if err != nil {
+			t.Fatalf("run %v: err = %v, want nil", run, err)
+		}
+		assertInducedMapping(t, g, pattern, mapping)
+	}
+}
+
+// assertInducedMapping checks the mapping is injective and preserves every edge count in both directions.
+func assertInducedMapping(t *testing.T, g, sub graph.Graph, mapping []int) {
+	t.Helper()
+	if len(mapping) != sub.Vertices() {
+		t.Fatalf("len(mapping) = %v, want %v", len(mapping), sub.Vertices())
+	}
+
+	used := make(map[int]bool)
+	for _, m := range mapping {
+		if used[m] {
+			t.Fatalf("mapping %v is not injective", mapping)
+		}
+		used[m] = true
+	}
+
+	for v := 0; v < sub.Vertices(); v++ {
+		for w := 0; w < sub.Vertices(); w++ {
+			if a, b := edgeCount(sub, v, w), edgeCount(g, mapping[v], mapping[w]); a != b {
+				t.Fatalf("mapping %v: %v→%v has %v edges, mapped %v", mapping, v, w, a, b)
+			}
+		}
+	}
+}
+
+func edgeCount(g graph.Graph, v, w int) int {
+	adj, _ := g.Adjacent(v)
+	var c int
+	for _, x := range adj {
+		if x == w {
+			c++
+		}
+	}
+	return c
+}
+
+func Test_wl_hash_distinguishes_when(t *testing.T) {
+	chain := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1}, 1: {2}, 2: {3}}))
+	star := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2, 3}}))
+	reversed := graph.New(graph.Vertices(4), graph.Downward(map[int][]int{0: {1, 2, 3}}))
+
+	hc, _ := graph.WLHash(chain, 4)
+	hs, _ := graph.WLHash(star, 4)
+	hr, _ := graph.WLHash(reversed, 4)
+	if hc == hs || hs == hr {
+		t.Errorf("WLHash() chain = %v, star = %v, reversed star = %v, want distinct", hc, hs, hr)
+	}
+}
@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// TopologicalLevels groups the vertices of a DAG into levels where every edge into a vertex originates from an
+// earlier level. Vertices within a level share no dependencies and can be processed concurrently.
+func TopologicalLevels(g Graph) ([][]int, error) {
+	in, err := inDegrees(g)
+	if err != nil {
+		return nil, err
+	}
+
+	var current []int
+	for v, d := range in {
+		if d == 0 {
+			current = append(current, v)
+		}
+	}
+
+	var levels [][]int
+	var seen int
+	for len(current) > 0 {
+		levels = append(levels, current)
+		seen += len(current)
+
+		var next []int
+		for _, v := range current {
+			adj, err := g.Adjacent(v)
+			if err != nil {
+				return nil, err
+			}
+			for _, w := range adj {
+				in[w]--
+				if in[w] == 0 {
+					next = append(next, w)
+				}
+			}
+		}
+		sort.Ints(next)
+		current = next
+	}
+
+	if seen != g.Vertices() {
+		return nil, ErrCyclicGraph
+	}
+
+	return levels, nil
+}
+
+// LongestPath returns the heaviest path through a DAG and its length where weight returns the cost of the
+// edge v→w.
+func LongestPath(g Graph, weight func(v, w int) float64) ([]int, float64, error) {
+	return heaviestPath(g, func(int) float64 { return 0 }, weight)
+}
+
+// CriticalPath returns the chain of vertices with the largest total cost and its length where cost returns
+// the duration of a vertex. The length is the minimum time to complete every vertex with unbounded parallelism.
+func CriticalPath(g Graph, cost func(v int) float64) ([]int, float64, error) {
+	return heaviestPath(g, cost, func(int, int) float64 { return 0 })
+}
+
+// heaviestPath relaxes the vertices in topological order to find the path with the largest combined vertex and
+// edge cost.
+func heaviestPath(g Graph, vertex func(v int) float64, edge func(v, w int) float64) ([]int, float64, error) {
+	if g.Vertices() == 0 {
+		return nil, 0, errors.ErrNoVertices
+	}
+
+	order, err := LexicographicalSort(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dist := make([]float64, g.Vertices())
+	prev := make([]int, g.Vertices())
+	for v := range dist {
+		dist[v] = vertex(v)
+		prev[v] = -1
+	}
+
+	for _, v := range order {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, w := range adj {
+			d := dist[v] + edge(v, w) + vertex(w)
+			if d > dist[w] {
+				dist[w] = d
+				prev[w] = v
+			}
+		}
+	}
+
+	end := order[0]
+	for _, v := range order {
+		if dist[v] > dist[end] {
+			end = v
+		}
+	}
+
+	var path []int
+	for v := end; v != -1; v = prev[v] {
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, dist[end], nil
+}
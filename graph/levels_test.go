@@ -0,0 +1,95 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+func Test_topological_levels_when(t *testing.T) {
+	tt := map[string]struct {
+		g      graph.Graph
+		levels [][]int
+	}{
+		"empty graph":      {graph.New(), nil},
+		"single node":      {graph.New(graph.Vertices(1)), [][]int{{0}}},
+		"three node chain": {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{1: {0}, 2: {1}})), [][]int{{2}, {1}, {0}}},
+		"example graph":    {exampleGraph(), [][]int{{0, 1, 2}, {3, 4}, {5, 6, 7}}},
+		"reverse example":  {reverso(), [][]int{{5, 6, 7}, {3, 4}, {0, 1, 2}}},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			levels, err := graph.TopologicalLevels(tc.g)
+			if err != nil {
+				t.Errorf("err=%v, want nil", err)
+			}
+			if !cmp.Equal(levels, tc.levels) {
+				t.Errorf("levels incorrect (+want,-got)\n%s", cmp.Diff(levels, tc.levels))
+			}
+		})
+	}
+}
+
+func Test_topological_levels_on_cyclic_graph_should_return_error(t *testing.T) {
+	g := graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}, 1: {0}}))
+
+	_, err := graph.TopologicalLevels(g)
+	if err != graph.ErrCyclicGraph {
+		t.Errorf("err=%v, want ErrCyclicGraph", err)
+	}
+}
+
+func Test_longest_path(t *testing.T) {
+	weights := map[[2]int]float64{
+		{0, 3}: 1, {1, 3}: 5, {1, 4}: 1, {2, 4}: 2, {2, 7}: 9,
+		{3, 5}: 1, {3, 6}: 2, {3, 7}: 1, {4, 6}: 1,
+	}
+
+	path, length, err := graph.LongestPath(exampleGraph(), func(v, w int) float64 {
+		return weights[[2]int{v, w}]
+	})
+	if err != nil {
+		t.Errorf("err=%v, want nil", err)
+	}
+	if length != 9 {
+		t.Errorf("length = %v, want 9", length)
+	}
+	if !cmp.Equal(path, []int{2, 7}) {
+		t.Errorf("path = %v, want [2 7]", path)
+	}
+}
+
+func Test_critical_path(t *testing.T) {
+	durations := []float64{3, 1, 2, 4, 1, 1, 2, 1}
+
+	path, length, err := graph.CriticalPath(exampleGraph(), func(v int) float64 {
+		return durations[v]
+	})
+	if err != nil {
+		t.Errorf("err=%v, want nil", err)
+	}
+	if length != 9 {
+		t.Errorf("length = %v, want 9", length)
+	}
+	if !cmp.Equal(path, []int{0, 3, 6}) {
+		t.Errorf("path = %v, want [0 3 6]", path)
+	}
+}
+
+func Test_critical_path_errors(t *testing.T) {
+	unit := func(int) float64 { return 1 }
+
+	_, _, err := graph.CriticalPath(graph.New(), unit)
+	if err != errors.ErrNoVertices {
+		t.Errorf("empty graph err=%v, want ErrNoVertices", err)
+	}
+
+	cyclic := graph.New(graph.Vertices(2), graph.Upward(map[int][]int{0: {1}, 1: {0}}))
+	_, _, err = graph.CriticalPath(cyclic, unit)
+	if err != graph.ErrCyclicGraph {
+		t.Errorf("cyclic graph err=%v, want ErrCyclicGraph", err)
+	}
+}
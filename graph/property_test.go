@@ -0,0 +1,126 @@
+package graph_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/check"
+)
+
+func Test_property_topological_sorts_respect_every_edge(t *testing.T) {
+	check.Run(t, 100, 200, func(r *rand.Rand) error {
+		n := r.Intn(30)
+		g := check.RandomDAG(r, n, r.Intn(n*3+1))
+
+		for _, sortFn := range []func(graph.Graph) ([]int, error){graph.TopologicalSort, graph.LexicographicalSort} {
+			order, err := sortFn(g)
+			if err != nil {
+				return err
+			}
+			if err := check.TopologicalOrder(g, order); err != nil {
+				return err
+			}
+		}
+
+		levels, err := graph.TopologicalLevels(g)
+		if err != nil {
+			return err
+		}
+		return check.Levels(g, levels)
+	})
+}
+
+func Test_property_incremental_dag_order(t *testing.T) {
+	check.Run(t, 300, 100, func(r *rand.Rand) error {
+		n := 1 + r.Intn(30)
+		g := graph.Acyclic()
+		graph.Vertices(n)(g)
+		for i := 0; i < n*3; i++ {
+			g.Edge(r.Intn(n), r.Intn(n))
+		}
+		return check.TopologicalOrder(g, g.Order())
+	})
+}
+
+func Test_property_degree_sums(t *testing.T) {
+	check.Run(t, 400, 200, func(r *rand.Rand) error {
+		n := r.Intn(30)
+		g := check.Random(r, n, r.Intn(n*3+1))
+		if err := check.DegreeSum(g); err != nil {
+			return err
+		}
+
+		tr, err := graph.Transpose(g)
+		if err != nil {
+			return err
+		}
+		return check.DegreeSum(tr)
+	})
+}
+
+func Test_property_components_partition_vertices(t *testing.T) {
+	check.Run(t, 500, 200, func(r *rand.Rand) error {
+		n := r.Intn(30)
+		g := check.Random(r, n, r.Intn(n*2+1))
+
+		components, err := graph.TwoEdgeConnectedComponents(g)
+		if err != nil {
+			return err
+		}
+		if err := check.Partition(g, components); err != nil {
+			return err
+		}
+
+		c, err := graph.Louvain(g, r.Int63())
+		if err != nil {
+			return err
+		}
+		return check.Assignment(g, c.Assignment)
+	})
+}
+
+func Test_property_longest_path_is_a_path(t *testing.T) {
+	check.Run(t, 600, 200, func(r *rand.Rand) error {
+		n := 1 + r.Intn(30)
+		g := check.RandomDAG(r, n, r.Intn(n*3+1))
+
+		path, _, err := graph.CriticalPath(g, func(v int) float64 { return float64(v % 7) })
+		if err != nil {
+			return err
+		}
+		return check.Path(g, path)
+	})
+}
+
+func Test_property_transpose_twice_is_identity(t *testing.T) {
+	check.Run(t, 700, 200, func(r *rand.Rand) error {
+		n := r.Intn(20)
+		g := check.Random(r, n, r.Intn(n*3+1))
+
+		tr, _ := graph.Transpose(g)
+		back, _ := graph.Transpose(tr)
+		if !graph.Equal(g, back) {
+			d, _ := graph.Diff(g, back)
+			return fmt.Errorf("transpose twice changed graph\n%v", d)
+		}
+
+		sub, remap, err := graph.Induced(g, r.Perm(n))
+		if err != nil {
+			return err
+		}
+		relabelled := graph.New(graph.Vertices(n))
+		for v := 0; v < n; v++ {
+			adj, _ := g.Adjacent(v)
+			for _, w := range adj {
+				relabelled.Edge(remap[v], remap[w])
+			}
+		}
+		if !graph.Equal(relabelled, sub) {
+			d, _ := graph.Diff(relabelled, sub)
+			return fmt.Errorf("induced subgraph of every vertex is not a relabelling\n%v", d)
+		}
+		return nil
+	})
+}
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"container/heap"
 	"errors"
 )
 
@@ -64,3 +65,144 @@ func visit(g Graph, n int, tracker map[int]int8, sorted []int) ([]int, error) {
 
 	return append([]int{n}, sorted...), nil
 }
+
+// LexicographicalSort returns the lexicographically smallest topological order using Kahn's algorithm.
+func LexicographicalSort(g Graph) ([]int, error) {
+	return StableSort(g, func(v, w int) bool { return v < w })
+}
+
+// StableSort returns a topological order using Kahn's algorithm. When more than one vertex is ready the
+// vertex ordered first by less is emitted, making the result independent of the vertex visiting order.
+func StableSort(g Graph, less func(v, w int) bool) ([]int, error) {
+	in, err := inDegrees(g)
+	if err != nil {
+		return nil, err
+	}
+
+	ready := &vertexHeap{less: less}
+	for v, d := range in {
+		if d == 0 {
+			ready.vertices = append(ready.vertices, v)
+		}
+	}
+	heap.Init(ready)
+
+	sorted := make([]int, 0, g.Vertices())
+	for ready.Len() > 0 {
+		v := heap.Pop(ready).(int)
+		sorted = append(sorted, v)
+
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range adj {
+			in[w]--
+			if in[w] == 0 {
+				heap.Push(ready, w)
+			}
+		}
+	}
+
+	if len(sorted) != g.Vertices() {
+		return nil, ErrCyclicGraph
+	}
+
+	return sorted, nil
+}
+
+// AllTopologicalSorts calls fn with every valid topological order of the graph. The order slice is reused
+// between calls and must be copied if retained. Returning true from fn will terminate the enumeration.
+// The number of orderings grows factorially with the vertex count so it is only suitable for small graphs.
+func AllTopologicalSorts(g Graph, fn func(order []int) bool) error {
+	in, err := inDegrees(g)
+	if err != nil {
+		return err
+	}
+
+	// Kahn's algorithm will consume all vertices if and only if the graph is acyclic.
+	_, err = StableSort(g, func(v, w int) bool { return v < w })
+	if err != nil {
+		return err
+	}
+
+	adjacent := make([][]int, g.Vertices())
+	for v := range adjacent {
+		adjacent[v], err = g.Adjacent(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	order := make([]int, 0, g.Vertices())
+	used := make([]bool, g.Vertices())
+
+	var enumerate func() bool
+	enumerate = func() bool {
+		if len(order) == len(used) {
+			return fn(order)
+		}
+
+		for v := range used {
+			if used[v] || in[v] != 0 {
+				continue
+			}
+
+			used[v] = true
+			order = append(order, v)
+			for _, w := range adjacent[v] {
+				in[w]--
+			}
+
+			stop := enumerate()
+
+			for _, w := range adjacent[v] {
+				in[w]++
+			}
+			order = order[:len(order)-1]
+			used[v] = false
+
+			if stop {
+				return true
+			}
+		}
+		return false
+	}
+	enumerate()
+
+	return nil
+}
+
+// inDegrees returns the number of edges directed into each vertex.
+func inDegrees(g Graph) ([]int, error) {
+	in := make([]int, g.Vertices())
+	for v := range in {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range adj {
+			in[w]++
+		}
+	}
+	return in, nil
+}
+
+// vertexHeap is a min-heap of vertices ordered by less.
+type vertexHeap struct {
+	vertices []int
+	less     func(v, w int) bool
+}
+
+func (h *vertexHeap) Len() int           { return len(h.vertices) }
+func (h *vertexHeap) Less(i, j int) bool { return h.less(h.vertices[i], h.vertices[j]) }
+func (h *vertexHeap) Swap(i, j int)      { h.vertices[i], h.vertices[j] = h.vertices[j], h.vertices[i] }
+func (h *vertexHeap) Push(x interface{}) { h.vertices = append(h.vertices, x.(int)) }
+
+func (h *vertexHeap) Pop() interface{} {
+	n := len(h.vertices) - 1
+	v := h.vertices[n]
+	h.vertices = h.vertices[:n]
+	return v
+}
@@ -71,3 +71,109 @@ func reverso() graph.Graph {
 		}),
 	)
 }
+
+func Test_lexicographical_sort_when(t *testing.T) {
+	tt := map[string]struct {
+		g     graph.Graph
+		order []int
+	}{
+		"empty graph":           {graph.New(), []int{}},
+		"single node":           {graph.New(graph.Vertices(1)), []int{0}},
+		"three node chain":      {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{1: {0}, 2: {1}})), []int{2, 1, 0}},
+		"example graph":         {exampleGraph(), []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		"reverse example graph": {reverso(), []int{5, 6, 4, 7, 2, 3, 0, 1}},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			o, err := graph.LexicographicalSort(tc.g)
+			if err != nil {
+				t.Errorf("err=%v, want nil", err)
+			}
+			if !cmp.Equal(o, tc.order) {
+				t.Errorf("topological order incorrect (+want,-got)\n%s", cmp.Diff(o, tc.order))
+			}
+		})
+	}
+}
+
+func Test_stable_sort_with_descending_tie_break(t *testing.T) {
+	o, err := graph.StableSort(exampleGraph(), func(v, w int) bool { return v > w })
+	if err != nil {
+		t.Errorf("err=%v, want nil", err)
+	}
+
+	want := []int{2, 1, 4, 0, 3, 7, 6, 5}
+	if !cmp.Equal(o, want) {
+		t.Errorf("topological order incorrect (+want,-got)\n%s", cmp.Diff(o, want))
+	}
+}
+
+func Test_stable_sort_is_repeatable(t *testing.T) {
+	first, _ := graph.LexicographicalSort(reverso())
+	for i := 0; i < 10; i++ {
+		o, _ := graph.LexicographicalSort(reverso())
+		if !cmp.Equal(o, first) {
+			t.Fatalf("run %d order = %v, want %v", i, o, first)
+		}
+	}
+}
+
+func Test_kahn_sorts_on_cyclic_graph_should_return_error(t *testing.T) {
+	g := graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}, 2: {1}}))
+
+	_, err := graph.LexicographicalSort(g)
+	if err != graph.ErrCyclicGraph {
+		t.Errorf("LexicographicalSort() err=%v, want ErrCyclicGraph", err)
+	}
+
+	err = graph.AllTopologicalSorts(g, func([]int) bool { return false })
+	if err != graph.ErrCyclicGraph {
+		t.Errorf("AllTopologicalSorts() err=%v, want ErrCyclicGraph", err)
+	}
+}
+
+func Test_all_topological_sorts_when(t *testing.T) {
+	tt := map[string]struct {
+		g      graph.Graph
+		orders [][]int
+	}{
+		"empty graph":       {graph.New(), [][]int{{}}},
+		"three node chain":  {graph.New(graph.Vertices(3), graph.Upward(map[int][]int{1: {0}, 2: {1}})), [][]int{{2, 1, 0}}},
+		"disconnected pair": {graph.New(graph.Vertices(2)), [][]int{{0, 1}, {1, 0}}},
+		"diamond": {
+			graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2}, 1: {3}, 2: {3}})),
+			[][]int{{0, 1, 2, 3}, {0, 2, 1, 3}},
+		},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			var orders [][]int
+			err := graph.AllTopologicalSorts(tc.g, func(o []int) bool {
+				orders = append(orders, append([]int{}, o...))
+				return false
+			})
+			if err != nil {
+				t.Errorf("err=%v, want nil", err)
+			}
+			if !cmp.Equal(orders, tc.orders) {
+				t.Errorf("orders incorrect (+want,-got)\n%s", cmp.Diff(orders, tc.orders))
+			}
+		})
+	}
+}
+
+func Test_all_topological_sorts_should_stop_when_fn_returns_true(t *testing.T) {
+	var calls int
+	err := graph.AllTopologicalSorts(graph.New(graph.Vertices(4)), func([]int) bool {
+		calls++
+		return calls == 3
+	})
+	if err != nil {
+		t.Errorf("err=%v, want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %v, want 3", calls)
+	}
+}
@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"github.com/nfisher/goalgo/graph/adjacency"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+// Transpose returns a new graph with the direction of every edge reversed.
+func Transpose(g Graph) (*adjacency.List, error) {
+	t := withVertices(g.Vertices())
+	for v := 0; v < g.Vertices(); v++ {
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range adj {
+			t.Edge(w, v)
+		}
+	}
+	return t, nil
+}
+
+// Induced returns the subgraph containing only the specified vertices and the edges between them. The vertices
+// are renumbered in the order given and the returned map translates an original id to its id in the subgraph.
+func Induced(g Graph, vertices []int) (*adjacency.List, map[int]int, error) {
+	remap := make(map[int]int, len(vertices))
+	for _, v := range vertices {
+		if v < 0 || v >= g.Vertices() {
+			return nil, nil, errors.Vertices(errors.ErrVertexNotFound, v)
+		}
+		if _, ok := remap[v]; !ok {
+			remap[v] = len(remap)
+		}
+	}
+
+	sub := withVertices(len(remap))
+	done := make(map[int]bool, len(remap))
+	for _, v := range vertices {
+		if done[v] {
+			continue
+		}
+		done[v] = true
+
+		adj, err := g.Adjacent(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, w := range adj {
+			if id, ok := remap[w]; ok {
+				sub.Edge(remap[v], id)
+			}
+		}
+	}
+
+	return sub, remap, nil
+}
+
+// Union returns a graph with the vertices of the larger graph and every distinct edge present in either graph.
+func Union(a, b Graph) (*adjacency.List, error) {
+	n := a.Vertices()
+	if b.Vertices() > n {
+		n = b.Vertices()
+	}
+
+	u := withVertices(n)
+	for v := 0; v < n; v++ {
+		seen := make(map[int]bool)
+		for _, g := range []Graph{a, b} {
+			if v >= g.Vertices() {
+				continue
+			}
+			adj, err := g.Adjacent(v)
+			if err != nil {
+				return nil, err
+			}
+			for _, w := range adj {
+				if !seen[w] {
+					seen[w] = true
+					u.Edge(v, w)
+				}
+			}
+		}
+	}
+
+	return u, nil
+}
+
+// Intersection returns a graph with the vertices common to both graphs and every distinct edge present in both.
+func Intersection(a, b Graph) (*adjacency.List, error) {
+	n := a.Vertices()
+	if b.Vertices() < n {
+		n = b.Vertices()
+	}
+
+	in := withVertices(n)
+	for v := 0; v < n; v++ {
+		adjB, err := b.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		inB := make(map[int]bool, len(adjB))
+		for _, w := range adjB {
+			inB[w] = true
+		}
+
+		adjA, err := a.Adjacent(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range adjA {
+			if inB[w] {
+				inB[w] = false
+				in.Edge(v, w)
+			}
+		}
+	}
+
+	return in, nil
+}
+
+// Contract contracts the edge between v and w merging w into v and returning a new graph with one less vertex.
+// An edge v→w or w→v must exist. Edges between v and w are removed and parallel edges created by the merge are
+// collapsed. The returned slice translates an original id to its new id.
+func Contract(g Graph, v, w int) (*adjacency.List, []int, error) {
+	n := g.Vertices()
+	if v < 0 || v >= n || w < 0 || w >= n {
+		return nil, nil, errors.Vertices(errors.ErrVertexNotFound, v, w)
+	}
+	if v == w {
+		return nil, nil, errors.Vertices(errors.ErrSelfLoop, v, w)
+	}
+	adjacent, err := connected(g, v, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !adjacent {
+		return nil, nil, errors.Vertices(errors.ErrEdgeNotFound, v, w)
+	}
+
+	remap := make([]int, n)
+	var id int
+	for u := range remap {
+		if u == w {
+			continue
+		}
+		remap[u] = id
+		id++
+	}
+	remap[w] = remap[v]
+
+	c := withVertices(id)
+	seen := make([]map[int]bool, id)
+	for u := 0; u < n; u++ {
+		adj, err := g.Adjacent(u)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		from := remap[u]
+		if seen[from] == nil {
+			seen[from] = make(map[int]bool)
+		}
+		for _, x := range adj {
+			to := remap[x]
+			merged := u == v || u == w || x == v || x == w
+			if merged && from == to {
+				continue
+			}
+			if merged && seen[from][to] {
+				continue
+			}
+			seen[from][to] = true
+			c.Edge(from, to)
+		}
+	}
+
+	return c, remap, nil
+}
+
+// connected returns true if g has an edge v→w or w→v.
+func connected(g Graph, v, w int) (bool, error) {
+	for _, e := range []Edge{{v, w}, {w, v}} {
+		adj, err := g.Adjacent(e.V)
+		if err != nil {
+			return false, err
+		}
+		for _, x := range adj {
+			if x == e.W {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// withVertices returns a new adjacency list initialised with n vertices.
+func withVertices(n int) *adjacency.List {
+	l := Directed()
+	Vertices(n)(l)
+	return l
+}
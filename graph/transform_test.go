@@ -0,0 +1,136 @@
+package graph_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nfisher/goalgo/graph"
+	"github.com/nfisher/goalgo/graph/errors"
+)
+
+func toJSON(t *testing.T, g graph.Graph) string {
+	t.Helper()
+	b, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal() err = %v, want nil", err)
+	}
+	return string(b)
+}
+
+func Test_transpose(t *testing.T) {
+	g := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{1: {0, 3}, 2: {1}, 3: {2}}))
+	before := toJSON(t, g)
+
+	tr, err := graph.Transpose(g)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	if got := toJSON(t, tr); got != "[[1],[2],[3],[1]]" {
+		t.Errorf("Transpose() = %v, want [[1],[2],[3],[1]]", got)
+	}
+	if got := toJSON(t, g); got != before {
+		t.Errorf("input mutated = %v, want %v", got, before)
+	}
+}
+
+func Test_transpose_of_example_matches_downward(t *testing.T) {
+	tr, _ := graph.Transpose(exampleGraph())
+
+	o, err := graph.LexicographicalSort(tr)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	want, _ := graph.LexicographicalSort(reverso())
+	if !cmp.Equal(o, want) {
+		t.Errorf("order (+want,-got)\n%s", cmp.Diff(o, want))
+	}
+}
+
+func Test_induced(t *testing.T) {
+	sub, remap, err := graph.Induced(exampleGraph(), []int{3, 1, 7, 3})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	if !cmp.Equal(remap, map[int]int{3: 0, 1: 1, 7: 2}) {
+		t.Errorf("remap = %v, want map[1:1 3:0 7:2]", remap)
+	}
+	if got := toJSON(t, sub); got != "[[2],[0],[]]" {
+		t.Errorf("Induced() = %v, want [[2],[0],[]]", got)
+	}
+
+	_, _, err = graph.Induced(exampleGraph(), []int{8})
+	if !errors.Is(err, errors.ErrVertexNotFound) {
+		t.Errorf("err = %v, want ErrVertexNotFound", err)
+	}
+}
+
+func Test_union_and_intersection(t *testing.T) {
+	a := graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1, 2}, 1: {2}}))
+	b := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {2, 3}, 2: {1}}))
+
+	u, err := graph.Union(a, b)
+	if err != nil {
+		t.Fatalf("Union() err = %v, want nil", err)
+	}
+	if got := toJSON(t, u); got != "[[1,2,3],[2],[1],[]]" {
+		t.Errorf("Union() = %v, want [[1,2,3],[2],[1],[]]", got)
+	}
+
+	in, err := graph.Intersection(a, b)
+	if err != nil {
+		t.Fatalf("Intersection() err = %v, want nil", err)
+	}
+	if got := toJSON(t, in); got != "[[2],[],[]]" {
+		t.Errorf("Intersection() = %v, want [[2],[],[]]", got)
+	}
+}
+
+func Test_contract(t *testing.T) {
+	g := graph.New(graph.Vertices(4), graph.Upward(map[int][]int{0: {1, 2}, 1: {3}, 2: {3}}))
+	before := toJSON(t, g)
+
+	c, remap, err := graph.Contract(g, 0, 1)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	if !cmp.Equal(remap, []int{0, 0, 1, 2}) {
+		t.Errorf("remap = %v, want [0 0 1 2]", remap)
+	}
+	if got := toJSON(t, c); got != "[[1,2],[2],[]]" {
+		t.Errorf("Contract() = %v, want [[1,2],[2],[]]", got)
+	}
+	if got := toJSON(t, g); got != before {
+		t.Errorf("input mutated = %v, want %v", got, before)
+	}
+
+	_, _, err = graph.Contract(g, 0, 4)
+	if !errors.Is(err, errors.ErrVertexNotFound) {
+		t.Errorf("err = %v, want ErrVertexNotFound", err)
+	}
+
+	_, _, err = graph.Contract(g, 1, 1)
+	if !errors.Is(err, errors.ErrSelfLoop) {
+		t.Errorf("err = %v, want ErrSelfLoop", err)
+	}
+
+	_, _, err = graph.Contract(g, 1, 2)
+	if !errors.Is(err, errors.ErrEdgeNotFound) {
+		t.Errorf("err = %v, want ErrEdgeNotFound", err)
+	}
+}
+
+func Test_contract_removes_edge_between_vertices(t *testing.T) {
+	g := graph.New(graph.Vertices(3), graph.Upward(map[int][]int{0: {1}, 1: {2}}))
+
+	c, _, err := graph.Contract(g, 1, 0)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if got := toJSON(t, c); got != "[[1],[]]" {
+		t.Errorf("Contract() = %v, want [[1],[]]", got)
+	}
+}
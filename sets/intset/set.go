@@ -1,5 +1,7 @@
 package intset
 
+import "sort"
+
 // Set provides integer based set primitives.
 type Set map[int]bool
 
@@ -26,3 +28,34 @@ func (s *Set) Contains(i int) bool {
 func (s *Set) Remove(i int) {
 	delete(*s, i)
 }
+
+// Len returns the number of elements in the set.
+func (s *Set) Len() int {
+	return len(*s)
+}
+
+// Intersection returns a new set containing the elements present in both sets.
+func (s *Set) Intersection(o Set) Set {
+	small, large := *s, o
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+
+	in := make(Set)
+	for i := range small {
+		if large[i] {
+			in.Add(i)
+		}
+	}
+	return in
+}
+
+// Values returns the elements of the set in ascending order.
+func (s *Set) Values() []int {
+	values := make([]int, 0, len(*s))
+	for i := range *s {
+		values = append(values, i)
+	}
+	sort.Ints(values)
+	return values
+}
@@ -1,6 +1,7 @@
 package intset_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/nfisher/goalgo/sets/intset"
@@ -71,3 +72,29 @@ func Test_remove(t *testing.T) {
 		})
 	}
 }
+
+func Test_intersection(t *testing.T) {
+	t.Parallel()
+	td := []struct {
+		name     string
+		set      intset.Set
+		other    intset.Set
+		expected []int
+	}{
+		{"should be empty for disjoint sets", intset.New(1, 2), intset.New(3), []int{}},
+		{"should contain common elements", intset.New(1, 2, 3), intset.New(3, 2, 5), []int{2, 3}},
+		{"should be empty for empty set", intset.New(), intset.New(1), []int{}},
+	}
+
+	for _, tc := range td {
+		t.Run(tc.name, func(t *testing.T) {
+			in := tc.set.Intersection(tc.other)
+			if !reflect.DeepEqual(in.Values(), tc.expected) {
+				t.Errorf("t.Intersection(%v) = %v, want %v", tc.other.Values(), in.Values(), tc.expected)
+			}
+			if in.Len() != len(tc.expected) {
+				t.Errorf("t.Len() = %v, want %v", in.Len(), len(tc.expected))
+			}
+		})
+	}
+}
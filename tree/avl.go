@@ -0,0 +1,257 @@
+package tree
+
+// AVL is a self-balancing binary search tree where the heights of sibling subtrees differ by at most one, keeping
+// Insert, Search and Delete at O(log n) regardless of insertion order. Each node records the size of its subtree
+// so Select and Rank are also O(log n). The zero value is an empty tree.
+type AVL struct {
+	root *avlNode
+	size int
+}
+
+type avlNode struct {
+	value  int
+	height int
+	size   int
+	left   *avlNode
+	right  *avlNode
+}
+
+// Insert adds a value to the tree, values already present are ignored.
+func (t *AVL) Insert(v int) {
+	var inserted bool
+	t.root, inserted = intAVL.insert(t.root, v)
+	if inserted {
+		t.size++
+	}
+}
+
+// Search finds the value in the tree.
+func (t *AVL) Search(v int) (int, error) {
+	n := t.root
+	for n != nil {
+		switch {
+		case v < n.value:
+			n = n.left
+		case v > n.value:
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+	return 0, ErrNotFound
+}
+
+// Delete removes the value from the tree.
+func (t *AVL) Delete(v int) error {
+	var deleted bool
+	t.root, deleted = intAVL.delete(t.root, v)
+	if !deleted {
+		return ErrNotFound
+	}
+	t.size--
+	return nil
+}
+
+// Min returns the minimum value in the tree.
+func (t *AVL) Min() (int, error) {
+	if t.root == nil {
+		return 0, ErrNilTree
+	}
+	return avlMin(t.root).value, nil
+}
+
+// Max returns the maximum value in the tree.
+func (t *AVL) Max() (int, error) {
+	n := t.root
+	if n == nil {
+		return 0, ErrNilTree
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, nil
+}
+
+// Len returns the number of values in the tree.
+func (t *AVL) Len() int {
+	return t.size
+}
+
+// Select returns the k-th smallest value in the tree counting from 0.
+func (t *AVL) Select(k int) (int, error) {
+	if k < 0 || k >= t.size {
+		return 0, ErrOutOfRange
+	}
+
+	n := t.root
+	for {
+		l := avlSize(n.left)
+		switch {
+		case k < l:
+			n = n.left
+		case k > l:
+			k -= l + 1
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+}
+
+// Rank returns the number of values in the tree less than v.
+func (t *AVL) Rank(v int) int {
+	var rank int
+	n := t.root
+	for n != nil {
+		if v <= n.value {
+			n = n.left
+		} else {
+			rank += avlSize(n.left) + 1
+			n = n.right
+		}
+	}
+	return rank
+}
+
+// avlOps parameterises the AVL balancing code. compare orders node values, nil uses the natural order of the
+// values. augment recomputes any data a tree keeps alongside its nodes after a node's children change, nil for
+// none. Trees ordered by something other than an int store an id per node and compare through a side table.
+type avlOps struct {
+	compare func(a, b int) int
+	augment func(n *avlNode)
+}
+
+// intAVL orders plain int values.
+var intAVL = &avlOps{}
+
+func (o *avlOps) insert(n *avlNode, v int) (*avlNode, bool) {
+	if n == nil {
+		n = &avlNode{value: v, height: 1, size: 1}
+		if o.augment != nil {
+			o.augment(n)
+		}
+		return n, true
+	}
+
+	var inserted bool
+	switch c := o.compareTo(v, n); {
+	case c < 0:
+		n.left, inserted = o.insert(n.left, v)
+	case c > 0:
+		n.right, inserted = o.insert(n.right, v)
+	default:
+		return n, false
+	}
+
+	return o.rebalance(n), inserted
+}
+
+func (o *avlOps) delete(n *avlNode, v int) (*avlNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch c := o.compareTo(v, n); {
+	case c < 0:
+		n.left, deleted = o.delete(n.left, v)
+	case c > 0:
+		n.right, deleted = o.delete(n.right, v)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// replace with the in-order successor and remove it from the right subtree.
+		n.value = avlMin(n.right).value
+		n.right, _ = o.delete(n.right, n.value)
+		deleted = true
+	}
+
+	return o.rebalance(n), deleted
+}
+
+// compareTo orders v against the value of n, plain int trees are compared inline without an indirect call.
+func (o *avlOps) compareTo(v int, n *avlNode) int {
+	switch {
+	case o.compare != nil:
+		return o.compare(v, n.value)
+	case v < n.value:
+		return -1
+	case v > n.value:
+		return 1
+	}
+	return 0
+}
+
+func avlMin(n *avlNode) *avlNode {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func avlHeight(n *avlNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func avlSize(n *avlNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (o *avlOps) update(n *avlNode) {
+	n.size = avlSize(n.left) + avlSize(n.right) + 1
+	l, r := avlHeight(n.left), avlHeight(n.right)
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+	if o.augment != nil {
+		o.augment(n)
+	}
+}
+
+// rebalance restores the height invariant at n with at most two rotations and returns the new subtree root.
+func (o *avlOps) rebalance(n *avlNode) *avlNode {
+	o.update(n)
+	switch balance := avlHeight(n.left) - avlHeight(n.right); {
+	case balance > 1:
+		if avlHeight(n.left.left) < avlHeight(n.left.right) {
+			n.left = o.rotateLeft(n.left)
+		}
+		return o.rotateRight(n)
+	case balance < -1:
+		if avlHeight(n.right.right) < avlHeight(n.right.left) {
+			n.right = o.rotateRight(n.right)
+		}
+		return o.rotateLeft(n)
+	}
+	return n
+}
+
+func (o *avlOps) rotateLeft(n *avlNode) *avlNode {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	o.update(n)
+	o.update(r)
+	return r
+}
+
+func (o *avlOps) rotateRight(n *avlNode) *avlNode {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	o.update(n)
+	o.update(l)
+	return l
+}
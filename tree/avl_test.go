@@ -0,0 +1,193 @@
+package tree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// ordered is the surface shared by the balanced trees.
+type ordered interface {
+	Insert(v int)
+	Search(v int) (int, error)
+	Delete(v int) error
+	Min() (int, error)
+	Max() (int, error)
+	Len() int
+}
+
+// exercise applies random inserts and deletes to tr checking it against a sorted slice oracle and calling verify
+// after every operation.
+func exercise(t *testing.T, tr ordered, verify func() error) {
+	r := rand.New(rand.NewSource(1))
+	var oracle []int
+
+	for i := 0; i < 2000; i++ {
+		v := r.Intn(300)
+		idx := sort.SearchInts(oracle, v)
+		present := idx < len(oracle) && oracle[idx] == v
+
+		if r.Intn(3) == 0 {
+			err := tr.Delete(v)
+			if present && err != nil {
+				t.Fatalf("Delete(%v) = %v, want nil", v, err)
+			}
+			if !present && err != ErrNotFound {
+				t.Fatalf("Delete(%v) = %v, want ErrNotFound", v, err)
+			}
+			if present {
+				oracle = append(oracle[:idx], oracle[idx+1:]...)
+			}
+		} else {
+			tr.Insert(v)
+			if !present {
+				oracle = append(oracle, 0)
+				copy(oracle[idx+1:], oracle[idx:])
+				oracle[idx] = v
+			}
+		}
+
+		if err := verify(); err != nil {
+			t.Fatalf("op %v: %v", i, err)
+		}
+		if tr.Len() != len(oracle) {
+			t.Fatalf("Len() = %v, want %v", tr.Len(), len(oracle))
+		}
+
+		idx = sort.SearchInts(oracle, v)
+		present = idx < len(oracle) && oracle[idx] == v
+		if _, err := tr.Search(v); (err == nil) != present {
+			t.Fatalf("Search(%v) err = %v, want present = %v", v, err, present)
+		}
+
+		if len(oracle) == 0 {
+			if _, err := tr.Min(); err != ErrNilTree {
+				t.Fatalf("Min() err = %v, want ErrNilTree", err)
+			}
+			continue
+		}
+		if min, _ := tr.Min(); min != oracle[0] {
+			t.Fatalf("Min() = %v, want %v", min, oracle[0])
+		}
+		if max, _ := tr.Max(); max != oracle[len(oracle)-1] {
+			t.Fatalf("Max() = %v, want %v", max, oracle[len(oracle)-1])
+		}
+	}
+
+	for v := 0; v < 300; v++ {
+		idx := sort.SearchInts(oracle, v)
+		present := idx < len(oracle) && oracle[idx] == v
+		got, err := tr.Search(v)
+		if present && (err != nil || got != v) {
+			t.Errorf("Search(%v) = %v, %v, want %v, nil", v, got, err, v)
+		}
+		if !present && err != ErrNotFound {
+			t.Errorf("Search(%v) err = %v, want ErrNotFound", v, err)
+		}
+	}
+}
+
+func Test_avl_against_oracle(t *testing.T) {
+	var tr AVL
+	exercise(t, &tr, func() error {
+		_, err := checkAVL(tr.root, minInt, maxInt)
+		return err
+	})
+}
+
+func Test_avl_select_and_rank(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	var tr AVL
+	var oracle []int
+
+	for i := 0; i < 1000; i++ {
+		v := r.Intn(200)
+		idx := sort.SearchInts(oracle, v)
+		present := idx < len(oracle) && oracle[idx] == v
+		if r.Intn(3) == 0 {
+			tr.Delete(v)
+			if present {
+				oracle = append(oracle[:idx], oracle[idx+1:]...)
+			}
+		} else {
+			tr.Insert(v)
+			if !present {
+				oracle = append(oracle, 0)
+				copy(oracle[idx+1:], oracle[idx:])
+				oracle[idx] = v
+			}
+		}
+
+		for k, want := range oracle {
+			if got, err := tr.Select(k); err != nil || got != want {
+				t.Fatalf("op %v: Select(%v) = %v, %v, want %v, nil", i, k, got, err, want)
+			}
+		}
+		if _, err := tr.Select(len(oracle)); err != ErrOutOfRange {
+			t.Fatalf("Select(%v) err = %v, want ErrOutOfRange", len(oracle), err)
+		}
+		if _, err := tr.Select(-1); err != ErrOutOfRange {
+			t.Fatalf("Select(-1) err = %v, want ErrOutOfRange", err)
+		}
+
+		q := r.Intn(220) - 10
+		if got, want := tr.Rank(q), sort.SearchInts(oracle, q); got != want {
+			t.Fatalf("op %v: Rank(%v) = %v, want %v", i, q, got, want)
+		}
+	}
+}
+
+func Test_avl_sorted_insert_stays_shallow(t *testing.T) {
+	var tr AVL
+	for v := 0; v < 1<<12; v++ {
+		tr.Insert(v)
+	}
+
+	// an AVL tree is at most ~1.44 log2(n) high.
+	if h := avlHeight(tr.root); h > 18 {
+		t.Errorf("height = %v, want <= 18", h)
+	}
+}
+
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+type invariantError string
+
+func (e invariantError) Error() string { return string(e) }
+
+// checkAVL verifies the ordering, recorded heights and balance of the subtree returning its height.
+func checkAVL(n *avlNode, lo, hi int) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if n.value < lo || n.value > hi {
+		return 0, invariantError("value out of order")
+	}
+
+	l, err := checkAVL(n.left, lo, n.value-1)
+	if err != nil {
+		return 0, err
+	}
+	r, err := checkAVL(n.right, n.value+1, hi)
+	if err != nil {
+		return 0, err
+	}
+
+	if l-r > 1 || r-l > 1 {
+		return 0, invariantError("subtree heights differ by more than one")
+	}
+	h := l + 1
+	if r > l {
+		h = r + 1
+	}
+	if n.height != h {
+		return 0, invariantError("recorded height incorrect")
+	}
+	if n.size != avlSize(n.left)+avlSize(n.right)+1 {
+		return 0, invariantError("recorded size incorrect")
+	}
+	return h, nil
+}
@@ -1,7 +1,10 @@
 package tree
 
 import (
-	"fmt"
+	"io"
+	"os"
+	"strconv"
+
 	"github.com/nfisher/goalgo/queue"
 )
 
@@ -13,8 +16,23 @@ type nodeLevel struct {
 	depth int
 }
 
-// BFSPrint prints all nodes in a tree using breadth-first search.
+// BFSPrint prints all nodes in a tree to stdout using breadth-first search.
 func BFSPrint(tree *BinaryTree) {
+	WriteLevels(os.Stdout, tree)
+}
+
+// WriteLevels writes all nodes in a tree using breadth-first search with one line per level. Missing children of
+// nodes are written as nil and every column is padded to the width of the widest value.
+func WriteLevels(w io.Writer, tree *BinaryTree) error {
+	width := len("nil")
+	LevelOrder(tree, func(n *BinaryTree, _ int) bool {
+		if l := len(strconv.Itoa(n.Value)); l > width {
+			width = l
+		}
+		return false
+	})
+
+	ew := &errWriter{w: w}
 	var level int
 	q := queue.New()
 	q.Enqueue(&nodeLevel{tree, level})
@@ -31,14 +49,14 @@ func BFSPrint(tree *BinaryTree) {
 		}
 
 		if nl.depth != level {
-			fmt.Println("")
+			ew.printf("\n")
 			level = nl.depth
 		}
 
 		if nl.tree == nil {
-			fmt.Printf("[       nil       ]\t")
+			ew.printf("%-*s\t", width, "nil")
 		} else {
-			fmt.Printf("%-18.0d\t", nl.tree.Value)
+			ew.printf("%-*d\t", width, nl.tree.Value)
 		}
 
 		if nl.tree != nil {
@@ -46,5 +64,6 @@ func BFSPrint(tree *BinaryTree) {
 			q.Enqueue(&nodeLevel{nl.tree.Right, nl.depth + 1})
 		}
 	}
-	fmt.Println("")
+	ew.printf("\n")
+	return ew.err
 }
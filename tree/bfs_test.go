@@ -1,16 +1,29 @@
 package tree_test
 
 import (
-	"fmt"
+	"bytes"
 	"testing"
 
 	"github.com/nfisher/goalgo/tree"
 )
 
 func Test_bfs(t *testing.T) {
-	t.Skip("print only")
-	root, scope := gen()
+	root := tree.Insert(nil, 4)
+	for _, v := range []int{2, 1000, 1} {
+		tree.Insert(root, v)
+	}
 
-	fmt.Println(len(scope.values))
-	tree.BFSPrint(root)
+	var buf bytes.Buffer
+	err := tree.WriteLevels(&buf, root)
+	if err != nil {
+		t.Fatalf("WriteLevels() = %v, want nil", err)
+	}
+
+	want := "4   \t\n" +
+		"2   \t1000\t\n" +
+		"1   \tnil \tnil \tnil \t\n" +
+		"nil \tnil \t\n"
+	if buf.String() != want {
+		t.Errorf("WriteLevels() =\n%q\nwant\n%q", buf.String(), want)
+	}
 }
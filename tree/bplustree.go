@@ -0,0 +1,296 @@
+package tree
+
+import "sort"
+
+// BPlusTree is a B+tree of minimum degree d. Values are only stored in the leaves, which are linked in ascending
+// order so range scans walk the leaves without revisiting internal nodes. Internal nodes hold separators where
+// every value in the child left of a separator is smaller than it and every value right of it is at least as
+// large.
+type BPlusTree struct {
+	degree int
+	root   *bNode
+	size   int
+}
+
+// NewBPlusTree creates an empty B+tree with the minimum degree provided which must be at least 2.
+func NewBPlusTree(degree int) (*BPlusTree, error) {
+	if degree < 2 {
+		return nil, ErrInvalidDegree
+	}
+	return &BPlusTree{degree: degree}, nil
+}
+
+// LoadBPlusTree creates a B+tree from strictly ascending values in O(n) without the splitting of repeated inserts.
+func LoadBPlusTree(degree int, sorted []int) (*BPlusTree, error) {
+	t, err := NewBPlusTree(degree)
+	if err != nil {
+		return nil, err
+	}
+	if err := ascending(sorted); err != nil {
+		return nil, err
+	}
+	if len(sorted) == 0 {
+		return t, nil
+	}
+
+	max := 2*degree - 1
+	count := (len(sorted) + max - 1) / max
+	base, extra := len(sorted)/count, len(sorted)%count
+
+	leaves := make([]*bNode, 0, count)
+	var seps []int
+	var pos int
+	for j := 0; j < count; j++ {
+		size := base
+		if j < extra {
+			size++
+		}
+		leaf := &bNode{keys: append([]int(nil), sorted[pos:pos+size]...)}
+		if j > 0 {
+			leaves[j-1].next = leaf
+			seps = append(seps, leaf.keys[0])
+		}
+		leaves = append(leaves, leaf)
+		pos += size
+	}
+
+	t.root = buildLevels(leaves, seps, degree)
+	t.size = len(sorted)
+	return t, nil
+}
+
+// Insert adds a value to the tree, values already present are ignored.
+func (t *BPlusTree) Insert(v int) {
+	if t.root == nil {
+		t.root = &bNode{keys: []int{v}}
+		t.size++
+		return
+	}
+
+	sep, right, inserted := t.insert(t.root, v)
+	if right != nil {
+		t.root = &bNode{keys: []int{sep}, children: []*bNode{t.root, right}}
+	}
+	if inserted {
+		t.size++
+	}
+}
+
+// Search finds the value in the tree.
+func (t *BPlusTree) Search(v int) (int, error) {
+	if t.root == nil {
+		return 0, ErrNotFound
+	}
+	leaf := t.leaf(v)
+	i := sort.SearchInts(leaf.keys, v)
+	if i < len(leaf.keys) && leaf.keys[i] == v {
+		return v, nil
+	}
+	return 0, ErrNotFound
+}
+
+// Delete removes the value from the tree.
+func (t *BPlusTree) Delete(v int) error {
+	if t.root == nil || !t.delete(t.root, v) {
+		return ErrNotFound
+	}
+
+	if len(t.root.keys) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	t.size--
+	return nil
+}
+
+// Min returns the minimum value in the tree.
+func (t *BPlusTree) Min() (int, error) {
+	if t.root == nil {
+		return 0, ErrNilTree
+	}
+	return t.root.min(), nil
+}
+
+// Max returns the maximum value in the tree.
+func (t *BPlusTree) Max() (int, error) {
+	if t.root == nil {
+		return 0, ErrNilTree
+	}
+	return t.root.max(), nil
+}
+
+// Len returns the number of values in the tree.
+func (t *BPlusTree) Len() int {
+	return t.size
+}
+
+// Ascend calls fn for each value in ascending order. Returning true will terminate the iteration.
+func (t *BPlusTree) Ascend(fn func(v int) bool) {
+	if t.root == nil {
+		return
+	}
+	t.scan(t.root.min(), fn)
+}
+
+// Range calls fn in ascending order for each value between lo and hi inclusive. Returning true will terminate the
+// iteration.
+func (t *BPlusTree) Range(lo, hi int, fn func(v int) bool) {
+	if t.root == nil {
+		return
+	}
+	t.scan(lo, func(v int) bool {
+		return v > hi || fn(v)
+	})
+}
+
+// scan calls fn for each value from lo onwards following the leaf links.
+func (t *BPlusTree) scan(lo int, fn func(v int) bool) {
+	leaf := t.leaf(lo)
+	i := sort.SearchInts(leaf.keys, lo)
+	for leaf != nil {
+		for ; i < len(leaf.keys); i++ {
+			if fn(leaf.keys[i]) {
+				return
+			}
+		}
+		leaf, i = leaf.next, 0
+	}
+}
+
+// leaf returns the leaf that v belongs in.
+func (t *BPlusTree) leaf(v int) *bNode {
+	n := t.root
+	for !n.leaf() {
+		n = n.children[child(n.keys, v)]
+	}
+	return n
+}
+
+// child returns the index of the child of an internal node that v belongs in.
+func child(keys []int, v int) int {
+	return sort.Search(len(keys), func(i int) bool { return keys[i] > v })
+}
+
+// insert adds v below n returning the separator and new right sibling when n overflows and is split.
+func (t *BPlusTree) insert(n *bNode, v int) (int, *bNode, bool) {
+	max := 2*t.degree - 1
+
+	if n.leaf() {
+		i := sort.SearchInts(n.keys, v)
+		if i < len(n.keys) && n.keys[i] == v {
+			return 0, nil, false
+		}
+		n.keys = insertKey(n.keys, i, v)
+		if len(n.keys) <= max {
+			return 0, nil, true
+		}
+
+		right := &bNode{keys: append([]int(nil), n.keys[t.degree:]...), next: n.next}
+		n.keys = n.keys[:t.degree]
+		n.next = right
+		return right.keys[0], right, true
+	}
+
+	i := child(n.keys, v)
+	sep, right, inserted := t.insert(n.children[i], v)
+	if right == nil {
+		return 0, nil, inserted
+	}
+
+	n.keys = insertKey(n.keys, i, sep)
+	n.children = insertChild(n.children, i+1, right)
+	if len(n.keys) <= max {
+		return 0, nil, inserted
+	}
+
+	// the median moves up rather than being copied as internal separators are not values.
+	sep = n.keys[t.degree]
+	right = &bNode{
+		keys:     append([]int(nil), n.keys[t.degree+1:]...),
+		children: append([]*bNode(nil), n.children[t.degree+1:]...),
+	}
+	n.keys = n.keys[:t.degree]
+	n.children = n.children[:t.degree+1]
+	return sep, right, inserted
+}
+
+// delete removes v from below n returning false if it is not present. Children left with fewer than degree-1
+// values borrow from or merge with a sibling on the way back up.
+func (t *BPlusTree) delete(n *bNode, v int) bool {
+	if n.leaf() {
+		i := sort.SearchInts(n.keys, v)
+		if i == len(n.keys) || n.keys[i] != v {
+			return false
+		}
+		n.keys = removeKey(n.keys, i)
+		return true
+	}
+
+	i := child(n.keys, v)
+	if !t.delete(n.children[i], v) {
+		return false
+	}
+	if len(n.children[i].keys) < t.degree-1 {
+		t.rebalance(n, i)
+	}
+	return true
+}
+
+// rebalance restores the minimum occupancy of the child of n at i.
+func (t *BPlusTree) rebalance(n *bNode, i int) {
+	c := n.children[i]
+	var left, right *bNode
+	if i > 0 {
+		left = n.children[i-1]
+	}
+	if i < len(n.keys) {
+		right = n.children[i+1]
+	}
+
+	switch {
+	case left != nil && len(left.keys) >= t.degree:
+		if c.leaf() {
+			c.keys = insertKey(c.keys, 0, left.keys[len(left.keys)-1])
+			n.keys[i-1] = c.keys[0]
+		} else {
+			c.keys = insertKey(c.keys, 0, n.keys[i-1])
+			n.keys[i-1] = left.keys[len(left.keys)-1]
+			c.children = insertChild(c.children, 0, left.children[len(left.children)-1])
+			left.children = left.children[:len(left.children)-1]
+		}
+		left.keys = left.keys[:len(left.keys)-1]
+	case right != nil && len(right.keys) >= t.degree:
+		if c.leaf() {
+			c.keys = append(c.keys, right.keys[0])
+			right.keys = removeKey(right.keys, 0)
+			n.keys[i] = right.keys[0]
+		} else {
+			c.keys = append(c.keys, n.keys[i])
+			n.keys[i] = right.keys[0]
+			right.keys = removeKey(right.keys, 0)
+			c.children = append(c.children, right.children[0])
+			right.children = removeChild(right.children, 0)
+		}
+	case left != nil:
+		t.merge(n, i-1)
+	default:
+		t.merge(n, i)
+	}
+}
+
+// merge folds the child of n at i+1 into the child at i.
+func (t *BPlusTree) merge(n *bNode, i int) {
+	c, s := n.children[i], n.children[i+1]
+	if c.leaf() {
+		c.next = s.next
+	} else {
+		c.keys = append(c.keys, n.keys[i])
+	}
+	c.keys = append(c.keys, s.keys...)
+	c.children = append(c.children, s.children...)
+	n.keys = removeKey(n.keys, i)
+	n.children = removeChild(n.children, i+1)
+}
@@ -0,0 +1,339 @@
+package tree
+
+import "sort"
+
+// BTree is a B-tree of minimum degree d where every node other than the root holds between d-1 and 2d-1 sorted
+// values. Storing many values per node keeps the tree shallow and the values of a node contiguous in memory.
+type BTree struct {
+	degree int
+	root   *bNode
+	size   int
+}
+
+// bNode is a node in a B-tree or B+tree. A node without children is a leaf, in a B+tree next links each leaf to
+// the leaf that follows it.
+type bNode struct {
+	keys     []int
+	children []*bNode
+	next     *bNode
+}
+
+func (n *bNode) leaf() bool {
+	return len(n.children) == 0
+}
+
+// NewBTree creates an empty B-tree with the minimum degree provided which must be at least 2.
+func NewBTree(degree int) (*BTree, error) {
+	if degree < 2 {
+		return nil, ErrInvalidDegree
+	}
+	return &BTree{degree: degree}, nil
+}
+
+// LoadBTree creates a B-tree from strictly ascending values in O(n) without the splitting of repeated inserts.
+func LoadBTree(degree int, sorted []int) (*BTree, error) {
+	t, err := NewBTree(degree)
+	if err != nil {
+		return nil, err
+	}
+	if err := ascending(sorted); err != nil {
+		return nil, err
+	}
+	if len(sorted) == 0 {
+		return t, nil
+	}
+
+	var leaves []*bNode
+	groups, seps := pack(sorted, 2*degree-1)
+	for _, g := range groups {
+		leaves = append(leaves, &bNode{keys: g})
+	}
+	t.root = buildLevels(leaves, seps, degree)
+	t.size = len(sorted)
+	return t, nil
+}
+
+// Insert adds a value to the tree, values already present are ignored.
+func (t *BTree) Insert(v int) {
+	if t.root == nil {
+		t.root = &bNode{keys: []int{v}}
+		t.size++
+		return
+	}
+	if _, err := t.Search(v); err == nil {
+		return
+	}
+
+	if len(t.root.keys) == 2*t.degree-1 {
+		r := &bNode{children: []*bNode{t.root}}
+		r.splitChild(0, t.degree)
+		t.root = r
+	}
+	t.root.insertNonFull(v, t.degree)
+	t.size++
+}
+
+// Search finds the value in the tree.
+func (t *BTree) Search(v int) (int, error) {
+	n := t.root
+	for n != nil {
+		i := sort.SearchInts(n.keys, v)
+		if i < len(n.keys) && n.keys[i] == v {
+			return v, nil
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	return 0, ErrNotFound
+}
+
+// Delete removes the value from the tree.
+func (t *BTree) Delete(v int) error {
+	if _, err := t.Search(v); err != nil {
+		return err
+	}
+
+	t.root.delete(v, t.degree)
+	if len(t.root.keys) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	t.size--
+	return nil
+}
+
+// Min returns the minimum value in the tree.
+func (t *BTree) Min() (int, error) {
+	if t.root == nil {
+		return 0, ErrNilTree
+	}
+	return t.root.min(), nil
+}
+
+// Max returns the maximum value in the tree.
+func (t *BTree) Max() (int, error) {
+	if t.root == nil {
+		return 0, ErrNilTree
+	}
+	return t.root.max(), nil
+}
+
+// Len returns the number of values in the tree.
+func (t *BTree) Len() int {
+	return t.size
+}
+
+// Ascend calls fn for each value in ascending order. Returning true will terminate the iteration.
+func (t *BTree) Ascend(fn func(v int) bool) {
+	if t.root != nil {
+		t.root.ascend(fn)
+	}
+}
+
+func (n *bNode) ascend(fn func(v int) bool) bool {
+	for i, k := range n.keys {
+		if !n.leaf() && n.children[i].ascend(fn) {
+			return true
+		}
+		if fn(k) {
+			return true
+		}
+	}
+	return !n.leaf() && n.children[len(n.keys)].ascend(fn)
+}
+
+func (n *bNode) min() int {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.keys[0]
+}
+
+func (n *bNode) max() int {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.keys[len(n.keys)-1]
+}
+
+// splitChild splits the full child at i moving its median value up into n.
+func (n *bNode) splitChild(i, degree int) {
+	y := n.children[i]
+	z := &bNode{keys: append([]int(nil), y.keys[degree:]...)}
+	if !y.leaf() {
+		z.children = append([]*bNode(nil), y.children[degree:]...)
+		y.children = y.children[:degree]
+	}
+	median := y.keys[degree-1]
+	y.keys = y.keys[:degree-1]
+
+	n.keys = insertKey(n.keys, i, median)
+	n.children = insertChild(n.children, i+1, z)
+}
+
+// insertNonFull adds v below n splitting full children on the way down so a split never has to propagate upwards.
+func (n *bNode) insertNonFull(v, degree int) {
+	for {
+		i := sort.SearchInts(n.keys, v)
+		if n.leaf() {
+			n.keys = insertKey(n.keys, i, v)
+			return
+		}
+		if len(n.children[i].keys) == 2*degree-1 {
+			n.splitChild(i, degree)
+			if v > n.keys[i] {
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// delete removes v from the subtree at n, v must be present. Every child descended into is first given at least
+// degree values so removing one never leaves it short.
+func (n *bNode) delete(v, degree int) {
+	for {
+		i := sort.SearchInts(n.keys, v)
+		if i < len(n.keys) && n.keys[i] == v {
+			if n.leaf() {
+				n.keys = removeKey(n.keys, i)
+				return
+			}
+			switch {
+			case len(n.children[i].keys) >= degree:
+				n.keys[i] = n.children[i].max()
+				v, n = n.keys[i], n.children[i]
+			case len(n.children[i+1].keys) >= degree:
+				n.keys[i] = n.children[i+1].min()
+				v, n = n.keys[i], n.children[i+1]
+			default:
+				n.merge(i)
+				n = n.children[i]
+			}
+			continue
+		}
+
+		if len(n.children[i].keys) < degree {
+			i = n.fill(i, degree)
+		}
+		n = n.children[i]
+	}
+}
+
+// fill gives the child at i at least degree values by borrowing from or merging with a sibling returning the
+// index of the child that now covers the same range.
+func (n *bNode) fill(i, degree int) int {
+	switch {
+	case i > 0 && len(n.children[i-1].keys) >= degree:
+		c, s := n.children[i], n.children[i-1]
+		c.keys = insertKey(c.keys, 0, n.keys[i-1])
+		n.keys[i-1] = s.keys[len(s.keys)-1]
+		s.keys = s.keys[:len(s.keys)-1]
+		if !s.leaf() {
+			c.children = insertChild(c.children, 0, s.children[len(s.children)-1])
+			s.children = s.children[:len(s.children)-1]
+		}
+	case i < len(n.keys) && len(n.children[i+1].keys) >= degree:
+		c, s := n.children[i], n.children[i+1]
+		c.keys = append(c.keys, n.keys[i])
+		n.keys[i] = s.keys[0]
+		s.keys = removeKey(s.keys, 0)
+		if !s.leaf() {
+			c.children = append(c.children, s.children[0])
+			s.children = removeChild(s.children, 0)
+		}
+	case i < len(n.keys):
+		n.merge(i)
+	default:
+		n.merge(i - 1)
+		i--
+	}
+	return i
+}
+
+// merge folds the value at i and the child to its right into the child to its left.
+func (n *bNode) merge(i int) {
+	c, s := n.children[i], n.children[i+1]
+	c.keys = append(c.keys, n.keys[i])
+	c.keys = append(c.keys, s.keys...)
+	c.children = append(c.children, s.children...)
+	n.keys = removeKey(n.keys, i)
+	n.children = removeChild(n.children, i+1)
+}
+
+// pack splits sorted into as few groups of at most max values as possible leaving a single separating value
+// between consecutive groups. The groups are sized evenly so none falls below half full.
+func pack(sorted []int, max int) (groups [][]int, seps []int) {
+	count := (len(sorted) + max + 1) / (max + 1)
+	content := len(sorted) - (count - 1)
+	base, extra := content/count, content%count
+
+	var pos int
+	for j := 0; j < count; j++ {
+		size := base
+		if j < extra {
+			size++
+		}
+		groups = append(groups, append([]int(nil), sorted[pos:pos+size]...))
+		pos += size
+		if j < count-1 {
+			seps = append(seps, sorted[pos])
+			pos++
+		}
+	}
+	return groups, seps
+}
+
+// buildLevels stacks internal nodes on top of nodes, which are separated by seps, until a single root remains.
+func buildLevels(nodes []*bNode, seps []int, degree int) *bNode {
+	for len(nodes) > 1 {
+		groups, next := pack(seps, 2*degree-1)
+		parents := make([]*bNode, 0, len(groups))
+		var c int
+		for _, g := range groups {
+			children := append([]*bNode(nil), nodes[c:c+len(g)+1]...)
+			parents = append(parents, &bNode{keys: g, children: children})
+			c += len(g) + 1
+		}
+		nodes, seps = parents, next
+	}
+	return nodes[0]
+}
+
+func ascending(sorted []int) error {
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1] >= sorted[i] {
+			return ErrUnsorted
+		}
+	}
+	return nil
+}
+
+func insertKey(keys []int, i, v int) []int {
+	keys = append(keys, 0)
+	copy(keys[i+1:], keys[i:])
+	keys[i] = v
+	return keys
+}
+
+func removeKey(keys []int, i int) []int {
+	return append(keys[:i], keys[i+1:]...)
+}
+
+func insertChild(children []*bNode, i int, c *bNode) []*bNode {
+	children = append(children, nil)
+	copy(children[i+1:], children[i:])
+	children[i] = c
+	return children
+}
+
+func removeChild(children []*bNode, i int) []*bNode {
+	copy(children[i:], children[i+1:])
+	children[len(children)-1] = nil
+	return children[:len(children)-1]
+}
@@ -0,0 +1,136 @@
+package tree
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// benchValues are distinct values in random order, sorted inserts would degenerate BinaryTree into a list.
+var benchValues = rand.New(rand.NewSource(1)).Perm(1 << 16)
+
+var benchResult int
+
+func Benchmark_Insert(b *testing.B) {
+	b.Run("binary tree", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			root := Insert(nil, benchValues[0])
+			for _, v := range benchValues[1:] {
+				Insert(root, v)
+			}
+		}
+	})
+
+	for _, d := range []int{2, 16, 64} {
+		b.Run(fmt.Sprintf("btree %v", d), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tr, _ := NewBTree(d)
+				for _, v := range benchValues {
+					tr.Insert(v)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("bplustree %v", d), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tr, _ := NewBPlusTree(d)
+				for _, v := range benchValues {
+					tr.Insert(v)
+				}
+			}
+		})
+	}
+}
+
+func Benchmark_Load(b *testing.B) {
+	sorted := append([]int(nil), benchValues...)
+	sort.Ints(sorted)
+
+	for _, d := range []int{16, 64} {
+		b.Run(fmt.Sprintf("btree %v", d), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				LoadBTree(d, sorted)
+			}
+		})
+		b.Run(fmt.Sprintf("bplustree %v", d), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				LoadBPlusTree(d, sorted)
+			}
+		})
+	}
+}
+
+func Benchmark_Search(b *testing.B) {
+	root := Insert(nil, benchValues[0])
+	for _, v := range benchValues[1:] {
+		Insert(root, v)
+	}
+	b.Run("binary tree", func(b *testing.B) {
+		var sum int
+		for i := 0; i < b.N; i++ {
+			n, _ := Search(root, benchValues[i%len(benchValues)])
+			sum += n.Value
+		}
+		benchResult = sum
+	})
+
+	sorted := append([]int(nil), benchValues...)
+	sort.Ints(sorted)
+	for _, d := range []int{2, 16, 64} {
+		bt, _ := LoadBTree(d, sorted)
+		b.Run(fmt.Sprintf("btree %v", d), func(b *testing.B) {
+			var sum int
+			for i := 0; i < b.N; i++ {
+				v, _ := bt.Search(benchValues[i%len(benchValues)])
+				sum += v
+			}
+			benchResult = sum
+		})
+
+		bp, _ := LoadBPlusTree(d, sorted)
+		b.Run(fmt.Sprintf("bplustree %v", d), func(b *testing.B) {
+			var sum int
+			for i := 0; i < b.N; i++ {
+				v, _ := bp.Search(benchValues[i%len(benchValues)])
+				sum += v
+			}
+			benchResult = sum
+		})
+	}
+}
+
+func Benchmark_Scan(b *testing.B) {
+	const span = 1024
+
+	root := Insert(nil, benchValues[0])
+	for _, v := range benchValues[1:] {
+		Insert(root, v)
+	}
+	b.Run("binary tree", func(b *testing.B) {
+		var sum int
+		for i := 0; i < b.N; i++ {
+			lo := benchValues[i%len(benchValues)]
+			for _, n := range Range(root, lo, lo+span) {
+				sum += n.Value
+			}
+		}
+		benchResult = sum
+	})
+
+	sorted := append([]int(nil), benchValues...)
+	sort.Ints(sorted)
+	for _, d := range []int{16, 64} {
+		bp, _ := LoadBPlusTree(d, sorted)
+		b.Run(fmt.Sprintf("bplustree %v", d), func(b *testing.B) {
+			var sum int
+			for i := 0; i < b.N; i++ {
+				lo := benchValues[i%len(benchValues)]
+				bp.Range(lo, lo+span, func(v int) bool {
+					sum += v
+					return false
+				})
+			}
+			benchResult = sum
+		})
+	}
+}
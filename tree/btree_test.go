@@ -0,0 +1,215 @@
+package tree
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+var degrees = []int{2, 3, 5}
+
+func Test_btree_against_oracle(t *testing.T) {
+	for _, d := range degrees {
+		t.Run(fmt.Sprintf("degree %v", d), func(t *testing.T) {
+			tr, err := NewBTree(d)
+			if err != nil {
+				t.Fatalf("NewBTree(%v) = %v, want nil", d, err)
+			}
+			exercise(t, tr, func() error {
+				return checkB(tr.root, d, false)
+			})
+		})
+	}
+}
+
+func Test_bplustree_against_oracle(t *testing.T) {
+	for _, d := range degrees {
+		t.Run(fmt.Sprintf("degree %v", d), func(t *testing.T) {
+			tr, err := NewBPlusTree(d)
+			if err != nil {
+				t.Fatalf("NewBPlusTree(%v) = %v, want nil", d, err)
+			}
+			exercise(t, tr, func() error {
+				return checkB(tr.root, d, true)
+			})
+		})
+	}
+}
+
+func Test_bulk_load(t *testing.T) {
+	for _, d := range degrees {
+		for n := 0; n < 200; n++ {
+			var sorted []int
+			for v := 0; v < n; v++ {
+				sorted = append(sorted, v*2)
+			}
+
+			bt, err := LoadBTree(d, sorted)
+			if err != nil {
+				t.Fatalf("LoadBTree(%v, %v values) = %v, want nil", d, n, err)
+			}
+			if err := checkB(bt.root, d, false); err != nil {
+				t.Fatalf("LoadBTree(%v, %v values): %v", d, n, err)
+			}
+			if got := ascendAll(bt.Ascend); !reflect.DeepEqual(got, sorted) {
+				t.Fatalf("LoadBTree(%v, %v values) = %v, want %v", d, n, got, sorted)
+			}
+			if bt.Len() != n {
+				t.Fatalf("Len() = %v, want %v", bt.Len(), n)
+			}
+
+			bp, err := LoadBPlusTree(d, sorted)
+			if err != nil {
+				t.Fatalf("LoadBPlusTree(%v, %v values) = %v, want nil", d, n, err)
+			}
+			if err := checkB(bp.root, d, true); err != nil {
+				t.Fatalf("LoadBPlusTree(%v, %v values): %v", d, n, err)
+			}
+			if got := ascendAll(bp.Ascend); !reflect.DeepEqual(got, sorted) {
+				t.Fatalf("LoadBPlusTree(%v, %v values) = %v, want %v", d, n, got, sorted)
+			}
+
+			// loaded trees remain usable.
+			bt.Insert(1)
+			bp.Insert(1)
+			if err := checkB(bt.root, d, false); err != nil {
+				t.Fatalf("LoadBTree(%v, %v values) then Insert: %v", d, n, err)
+			}
+			if err := checkB(bp.root, d, true); err != nil {
+				t.Fatalf("LoadBPlusTree(%v, %v values) then Insert: %v", d, n, err)
+			}
+		}
+	}
+}
+
+func Test_bulk_load_errors(t *testing.T) {
+	if _, err := LoadBTree(1, nil); err != ErrInvalidDegree {
+		t.Errorf("LoadBTree(1) err = %v, want ErrInvalidDegree", err)
+	}
+	if _, err := LoadBPlusTree(2, []int{1, 3, 3}); err != ErrUnsorted {
+		t.Errorf("LoadBPlusTree([1 3 3]) err = %v, want ErrUnsorted", err)
+	}
+	if _, err := LoadBTree(2, []int{2, 1}); err != ErrUnsorted {
+		t.Errorf("LoadBTree([2 1]) err = %v, want ErrUnsorted", err)
+	}
+}
+
+func Test_bplustree_range(t *testing.T) {
+	var sorted []int
+	for v := 0; v < 100; v += 3 {
+		sorted = append(sorted, v)
+	}
+	tr, _ := LoadBPlusTree(2, sorted)
+
+	tt := map[string]struct {
+		lo, hi int
+		limit  int
+		want   []int
+	}{
+		"inside":        {10, 20, -1, []int{12, 15, 18}},
+		"bounds match":  {12, 18, -1, []int{12, 15, 18}},
+		"before":        {-10, 1, -1, []int{0}},
+		"after":         {98, 200, -1, []int{99}},
+		"empty":         {13, 14, -1, nil},
+		"terminated":    {0, 99, 2, []int{0, 3}},
+		"reversed span": {20, 10, -1, nil},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			var got []int
+			tr.Range(tc.lo, tc.hi, func(v int) bool {
+				got = append(got, v)
+				return len(got) == tc.limit
+			})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Range(%v, %v) = %v, want %v", tc.lo, tc.hi, got, tc.want)
+			}
+		})
+	}
+}
+
+func ascendAll(ascend func(fn func(v int) bool)) []int {
+	var values []int
+	ascend(func(v int) bool {
+		values = append(values, v)
+		return false
+	})
+	return values
+}
+
+// checkB verifies the ordering, occupancy and uniform leaf depth of a B-tree or B+tree. For a B+tree it also
+// checks the leaf links visit every value in order.
+func checkB(root *bNode, degree int, plus bool) error {
+	if root == nil {
+		return nil
+	}
+
+	var leaves []*bNode
+	leafDepth := -1
+	var visit func(n *bNode, lo, hi int, depth int) error
+	visit = func(n *bNode, lo, hi int, depth int) error {
+		if len(n.keys) > 2*degree-1 {
+			return invariantError("node overfull")
+		}
+		if n != root && len(n.keys) < degree-1 {
+			return invariantError("node underfull")
+		}
+		if len(n.keys) == 0 {
+			return invariantError("node empty")
+		}
+		for i, k := range n.keys {
+			if k < lo || k > hi || (i > 0 && n.keys[i-1] >= k) {
+				return invariantError("value out of order")
+			}
+		}
+
+		if n.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			if depth != leafDepth {
+				return invariantError("leaves at different depths")
+			}
+			leaves = append(leaves, n)
+			return nil
+		}
+
+		if len(n.children) != len(n.keys)+1 {
+			return invariantError("child count does not match value count")
+		}
+		for i, c := range n.children {
+			clo, chi := lo, hi
+			if i > 0 {
+				clo = n.keys[i-1]
+				if !plus {
+					clo++
+				}
+			}
+			if i < len(n.keys) {
+				chi = n.keys[i] - 1
+			}
+			if err := visit(c, clo, chi, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := visit(root, minInt, maxInt, 0); err != nil {
+		return err
+	}
+
+	if plus {
+		for i, l := range leaves {
+			var next *bNode
+			if i+1 < len(leaves) {
+				next = leaves[i+1]
+			}
+			if l.next != next {
+				return invariantError("leaf link broken")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package tree
+
+// Interval is the closed range of integers from Start to End inclusive.
+type Interval struct {
+	Start int
+	End   int
+}
+
+// Overlaps returns true if the intervals share at least one point.
+func (i Interval) Overlaps(o Interval) bool {
+	return i.Start <= o.End && o.Start <= i.End
+}
+
+// compare orders intervals by start and then end.
+func (i Interval) compare(o Interval) int {
+	switch {
+	case i.Start < o.Start:
+		return -1
+	case i.Start > o.Start:
+		return 1
+	case i.End < o.End:
+		return -1
+	case i.End > o.End:
+		return 1
+	}
+	return 0
+}
+
+// IntervalTree is an AVL tree of intervals keyed by start where each node records the maximum end in its subtree,
+// allowing overlap queries to skip subtrees that end before the query begins. Identical intervals may be inserted
+// more than once. The zero value is an empty tree.
+type IntervalTree struct {
+	root *avlNode
+	ops  *avlOps
+
+	// nodes hold ids into intervals and max, ids released by Delete are kept in free for reuse.
+	intervals []Interval
+	max       []int
+	free      []int
+}
+
+// balancer returns the ops that order ids by their interval, breaking ties on the id so duplicates are kept, and
+// maintain the maximum end of each subtree.
+func (t *IntervalTree) balancer() *avlOps {
+	if t.ops != nil {
+		return t.ops
+	}
+
+	t.ops = &avlOps{
+		compare: func(a, b int) int {
+			if c := t.intervals[a].compare(t.intervals[b]); c != 0 {
+				return c
+			}
+			return a - b
+		},
+		augment: func(n *avlNode) {
+			max := t.intervals[n.value].End
+			if n.left != nil && t.max[n.left.value] > max {
+				max = t.max[n.left.value]
+			}
+			if n.right != nil && t.max[n.right.value] > max {
+				max = t.max[n.right.value]
+			}
+			t.max[n.value] = max
+		},
+	}
+	return t.ops
+}
+
+// Insert adds an interval to the tree. Intervals with End before Start are rejected with ErrInvalidInterval.
+func (t *IntervalTree) Insert(i Interval) error {
+	if i.End < i.Start {
+		return ErrInvalidInterval
+	}
+
+	var id int
+	if len(t.free) > 0 {
+		id = t.free[len(t.free)-1]
+		t.free = t.free[:len(t.free)-1]
+		t.intervals[id] = i
+	} else {
+		id = len(t.intervals)
+		t.intervals = append(t.intervals, i)
+		t.max = append(t.max, i.End)
+	}
+
+	t.root, _ = t.balancer().insert(t.root, id)
+	return nil
+}
+
+// Delete removes one occurrence of the interval from the tree.
+func (t *IntervalTree) Delete(i Interval) error {
+	n := t.root
+	for n != nil {
+		c := i.compare(t.intervals[n.value])
+		if c == 0 {
+			break
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	if n == nil {
+		return ErrNotFound
+	}
+
+	id := n.value
+	t.root, _ = t.balancer().delete(t.root, id)
+	t.free = append(t.free, id)
+	return nil
+}
+
+// Len returns the number of intervals in the tree.
+func (t *IntervalTree) Len() int {
+	return avlSize(t.root)
+}
+
+// Containing returns the intervals that contain the point p ordered by start and then end.
+func (t *IntervalTree) Containing(p int) []Interval {
+	return t.Overlapping(Interval{p, p})
+}
+
+// Overlapping returns the intervals that share at least one point with q ordered by start and then end.
+func (t *IntervalTree) Overlapping(q Interval) []Interval {
+	var found []Interval
+	var visit func(n *avlNode)
+	visit = func(n *avlNode) {
+		// nothing in this subtree ends at or after the query start.
+		if n == nil || t.max[n.value] < q.Start {
+			return
+		}
+		visit(n.left)
+		i := t.intervals[n.value]
+		if i.Overlaps(q) {
+			found = append(found, i)
+		}
+		// everything to the right starts after the query ends.
+		if i.Start <= q.End {
+			visit(n.right)
+		}
+	}
+	visit(t.root)
+	return found
+}
@@ -0,0 +1,162 @@
+package tree
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func Test_interval_tree_against_oracle(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	var tr IntervalTree
+	// oracle counts the occurrences of each interval.
+	oracle := map[Interval]int{}
+	var size int
+
+	for i := 0; i < 2000; i++ {
+		start := r.Intn(50)
+		iv := Interval{start, start + r.Intn(10)}
+
+		if r.Intn(3) == 0 {
+			err := tr.Delete(iv)
+			if oracle[iv] > 0 && err != nil {
+				t.Fatalf("Delete(%v) = %v, want nil", iv, err)
+			}
+			if oracle[iv] == 0 && err != ErrNotFound {
+				t.Fatalf("Delete(%v) = %v, want ErrNotFound", iv, err)
+			}
+			if oracle[iv] > 0 {
+				oracle[iv]--
+				size--
+			}
+		} else {
+			if err := tr.Insert(iv); err != nil {
+				t.Fatalf("Insert(%v) = %v, want nil", iv, err)
+			}
+			oracle[iv]++
+			size++
+		}
+
+		if _, err := checkInterval(&tr, tr.root); err != nil {
+			t.Fatalf("op %v: %v", i, err)
+		}
+		if tr.Len() != size {
+			t.Fatalf("Len() = %v, want %v", tr.Len(), size)
+		}
+
+		lo := r.Intn(70) - 10
+		q := Interval{lo, lo + r.Intn(10)}
+		var want []Interval
+		for o, count := range oracle {
+			for ; o.Overlaps(q) && count > 0; count-- {
+				want = append(want, o)
+			}
+		}
+		sort.Slice(want, func(i, j int) bool { return want[i].compare(want[j]) < 0 })
+		if got := tr.Overlapping(q); !reflect.DeepEqual(got, want) {
+			t.Fatalf("op %v: Overlapping(%v) = %v, want %v", i, q, got, want)
+		}
+	}
+}
+
+func Test_interval_tree_containing(t *testing.T) {
+	var tr IntervalTree
+	for _, iv := range []Interval{{15, 20}, {10, 30}, {17, 19}, {5, 20}, {12, 15}, {30, 40}} {
+		tr.Insert(iv)
+	}
+
+	tt := map[string]struct {
+		p    int
+		want []Interval
+	}{
+		"before all":   {0, nil},
+		"shared start": {5, []Interval{{5, 20}}},
+		"inner":        {18, []Interval{{5, 20}, {10, 30}, {15, 20}, {17, 19}}},
+		"shared end":   {30, []Interval{{10, 30}, {30, 40}}},
+		"after all":    {41, nil},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			if got := tr.Containing(tc.p); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Containing(%v) = %v, want %v", tc.p, got, tc.want)
+			}
+		})
+	}
+}
+
+func Test_interval_tree_rejects_reversed(t *testing.T) {
+	var tr IntervalTree
+	if err := tr.Insert(Interval{2, 1}); err != ErrInvalidInterval {
+		t.Errorf("Insert({2 1}) = %v, want ErrInvalidInterval", err)
+	}
+}
+
+func Test_interval_tree_keeps_duplicates(t *testing.T) {
+	var tr IntervalTree
+	tr.Insert(Interval{1, 5})
+	tr.Insert(Interval{1, 5})
+	tr.Insert(Interval{2, 3})
+
+	if tr.Len() != 3 {
+		t.Errorf("Len() = %v, want 3", tr.Len())
+	}
+	want := []Interval{{1, 5}, {1, 5}, {2, 3}}
+	if got := tr.Containing(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("Containing(3) = %v, want %v", got, want)
+	}
+
+	if err := tr.Delete(Interval{1, 5}); err != nil {
+		t.Fatalf("Delete({1 5}) = %v, want nil", err)
+	}
+	want = []Interval{{1, 5}, {2, 3}}
+	if got := tr.Containing(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("Containing(3) after Delete = %v, want %v", got, want)
+	}
+}
+
+// checkInterval verifies the ordering, balance and recorded maximum ends of the subtree returning its height.
+func checkInterval(tr *IntervalTree, n *avlNode) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	ops := tr.balancer()
+	if n.left != nil && ops.compare(n.left.value, n.value) >= 0 {
+		return 0, invariantError("left interval out of order")
+	}
+	if n.right != nil && ops.compare(n.value, n.right.value) >= 0 {
+		return 0, invariantError("right interval out of order")
+	}
+
+	l, err := checkInterval(tr, n.left)
+	if err != nil {
+		return 0, err
+	}
+	r, err := checkInterval(tr, n.right)
+	if err != nil {
+		return 0, err
+	}
+	if l-r > 1 || r-l > 1 {
+		return 0, invariantError("subtree heights differ by more than one")
+	}
+	if n.size != avlSize(n.left)+avlSize(n.right)+1 {
+		return 0, invariantError("recorded size incorrect")
+	}
+
+	max := tr.intervals[n.value].End
+	if n.left != nil && tr.max[n.left.value] > max {
+		max = tr.max[n.left.value]
+	}
+	if n.right != nil && tr.max[n.right.value] > max {
+		max = tr.max[n.right.value]
+	}
+	if tr.max[n.value] != max {
+		return 0, invariantError("recorded max end incorrect")
+	}
+
+	if l > r {
+		return l + 1, nil
+	}
+	return r + 1, nil
+}
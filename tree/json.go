@@ -0,0 +1,67 @@
+package tree
+
+import "encoding/json"
+
+// jsonNode is the encoded form of a BinaryTree, Parent pointers are implied by nesting.
+type jsonNode struct {
+	Value int       `json:"value"`
+	Left  *jsonNode `json:"left,omitempty"`
+	Right *jsonNode `json:"right,omitempty"`
+}
+
+// MarshalJSON encodes the tree as nested objects of value, left and right.
+func (t *BinaryTree) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toJSON(t))
+}
+
+// UnmarshalJSON populates the tree from nested objects of value, left and right setting the Parent pointers of
+// every node below t. Trees whose values are not in search order are rejected with ErrUnsorted. Following the
+// encoding/json convention a null document is a no-op leaving t untouched.
+func (t *BinaryTree) UnmarshalJSON(b []byte) error {
+	var p *jsonNode
+	err := json.Unmarshal(b, &p)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return nil
+	}
+	n := *p
+
+	var values []int
+	var walk func(n *jsonNode)
+	walk = func(n *jsonNode) {
+		if n == nil {
+			return
+		}
+		walk(n.Left)
+		values = append(values, n.Value)
+		walk(n.Right)
+	}
+	walk(&n)
+	if err := ascending(values); err != nil {
+		return err
+	}
+
+	t.Value = n.Value
+	t.Left = fromJSON(n.Left, t)
+	t.Right = fromJSON(n.Right, t)
+	return nil
+}
+
+func toJSON(t *BinaryTree) *jsonNode {
+	if t == nil {
+		return nil
+	}
+	return &jsonNode{Value: t.Value, Left: toJSON(t.Left), Right: toJSON(t.Right)}
+}
+
+func fromJSON(n *jsonNode, parent *BinaryTree) *BinaryTree {
+	if n == nil {
+		return nil
+	}
+	t := &BinaryTree{Value: n.Value, Parent: parent}
+	t.Left = fromJSON(n.Left, t)
+	t.Right = fromJSON(n.Right, t)
+	return t
+}
@@ -0,0 +1,199 @@
+package tree
+
+import (
+	"strings"
+	"time"
+)
+
+// Comparator orders two keys returning a negative number when a < b, zero when a == b and a positive number
+// when a > b.
+type Comparator func(a, b interface{}) int
+
+// IntComparator orders int keys.
+func IntComparator(a, b interface{}) int {
+	x, y := a.(int), b.(int)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// StringComparator orders string keys.
+func StringComparator(a, b interface{}) int {
+	return strings.Compare(a.(string), b.(string))
+}
+
+// TimeComparator orders time.Time keys.
+func TimeComparator(a, b interface{}) int {
+	x, y := a.(time.Time), b.(time.Time)
+	switch {
+	case x.Before(y):
+		return -1
+	case x.After(y):
+		return 1
+	}
+	return 0
+}
+
+// OrderedMap is an ordered map of keys to values backed by a left-leaning red-black tree. Keys may be of any type
+// the comparator understands.
+type OrderedMap struct {
+	compare Comparator
+	ops     *rbOps
+	root    *rbNode
+	size    int
+
+	// nodes hold an id into keys and values, ids of deleted keys are kept in free for reuse.
+	keys   []interface{}
+	values []interface{}
+	free   []int
+}
+
+// NewOrderedMap creates an empty map ordered by compare.
+func NewOrderedMap(compare Comparator) *OrderedMap {
+	m := &OrderedMap{compare: compare}
+	m.ops = &rbOps{compare: func(a, b int) int {
+		return m.compare(m.keys[a], m.keys[b])
+	}}
+	return m
+}
+
+// Put associates value with key replacing any existing value.
+func (m *OrderedMap) Put(key, value interface{}) {
+	id := m.alloc(key, value)
+	var found *rbNode
+	m.root, found = m.ops.insert(m.root, id)
+	m.root.red = false
+	if found != nil {
+		m.values[found.value] = value
+		m.release(id)
+		return
+	}
+	m.size++
+}
+
+// Get returns the value associated with key.
+func (m *OrderedMap) Get(key interface{}) (interface{}, error) {
+	n := m.find(key)
+	if n == nil {
+		return nil, ErrNotFound
+	}
+	return m.values[n.value], nil
+}
+
+// Contains returns true if the key is present in the map.
+func (m *OrderedMap) Contains(key interface{}) bool {
+	return m.find(key) != nil
+}
+
+// Delete removes the key and its value from the map.
+func (m *OrderedMap) Delete(key interface{}) error {
+	n := m.find(key)
+	if n == nil {
+		return ErrNotFound
+	}
+	id := n.value
+
+	if !isRed(m.root.left) && !isRed(m.root.right) {
+		m.root.red = true
+	}
+	m.root = m.ops.delete(m.root, id)
+	if m.root != nil {
+		m.root.red = false
+	}
+	m.release(id)
+	m.size--
+	return nil
+}
+
+// Min returns the smallest key and its value.
+func (m *OrderedMap) Min() (interface{}, interface{}, error) {
+	if m.root == nil {
+		return nil, nil, ErrNilTree
+	}
+	id := rbMin(m.root).value
+	return m.keys[id], m.values[id], nil
+}
+
+// Max returns the largest key and its value.
+func (m *OrderedMap) Max() (interface{}, interface{}, error) {
+	n := m.root
+	if n == nil {
+		return nil, nil, ErrNilTree
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return m.keys[n.value], m.values[n.value], nil
+}
+
+// Len returns the number of keys in the map.
+func (m *OrderedMap) Len() int {
+	return m.size
+}
+
+// Ascend calls fn for each key and value in ascending key order. Returning true will terminate the iteration.
+func (m *OrderedMap) Ascend(fn func(key, value interface{}) bool) {
+	var stack []*rbNode
+	n := m.root
+	for n != nil || len(stack) > 0 {
+		for n != nil {
+			stack = append(stack, n)
+			n = n.left
+		}
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if fn(m.keys[n.value], m.values[n.value]) {
+			return
+		}
+		n = n.right
+	}
+}
+
+// Keys returns the keys in ascending order.
+func (m *OrderedMap) Keys() []interface{} {
+	keys := make([]interface{}, 0, m.size)
+	m.Ascend(func(key, _ interface{}) bool {
+		keys = append(keys, key)
+		return false
+	})
+	return keys
+}
+
+func (m *OrderedMap) find(key interface{}) *rbNode {
+	n := m.root
+	for n != nil {
+		c := m.compare(key, m.keys[n.value])
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// alloc stores key and value under a free id.
+func (m *OrderedMap) alloc(key, value interface{}) int {
+	if n := len(m.free); n > 0 {
+		id := m.free[n-1]
+		m.free = m.free[:n-1]
+		m.keys[id], m.values[id] = key, value
+		return id
+	}
+	m.keys = append(m.keys, key)
+	m.values = append(m.values, value)
+	return len(m.keys) - 1
+}
+
+// release frees id for reuse, dropping its key and value so they can be collected.
+func (m *OrderedMap) release(id int) {
+	m.keys[id], m.values[id] = nil, nil
+	m.free = append(m.free, id)
+}
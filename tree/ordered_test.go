@@ -0,0 +1,160 @@
+package tree
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func Test_ordered_map_against_oracle(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewOrderedMap(StringComparator)
+	oracle := map[string]int{}
+
+	for i := 0; i < 2000; i++ {
+		k := fmt.Sprintf("k%03d", r.Intn(300))
+		_, present := oracle[k]
+
+		if r.Intn(3) == 0 {
+			err := m.Delete(k)
+			if present && err != nil {
+				t.Fatalf("Delete(%v) = %v, want nil", k, err)
+			}
+			if !present && err != ErrNotFound {
+				t.Fatalf("Delete(%v) = %v, want ErrNotFound", k, err)
+			}
+			delete(oracle, k)
+		} else {
+			m.Put(k, i)
+			oracle[k] = i
+		}
+
+		if isRed(m.root) {
+			t.Fatalf("op %v: root is red", i)
+		}
+		if _, err := checkOrderedMap(m, m.root, nil, nil); err != nil {
+			t.Fatalf("op %v: %v", i, err)
+		}
+		if m.Len() != len(oracle) {
+			t.Fatalf("Len() = %v, want %v", m.Len(), len(oracle))
+		}
+
+		v, err := m.Get(k)
+		want, present := oracle[k]
+		if (err == nil) != present || (present && v != want) {
+			t.Fatalf("Get(%v) = %v, %v, want %v, %v", k, v, err, want, present)
+		}
+	}
+
+	var keys []interface{}
+	for k := range oracle {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].(string) < keys[j].(string) })
+	if got := m.Keys(); !reflect.DeepEqual(got, keys) {
+		t.Errorf("Keys() = %v, want %v", got, keys)
+	}
+}
+
+func Test_ordered_map_struct_keys(t *testing.T) {
+	type point struct{ x, y int }
+	byXY := func(a, b interface{}) int {
+		p, q := a.(point), b.(point)
+		if p.x != q.x {
+			return p.x - q.x
+		}
+		return p.y - q.y
+	}
+
+	m := NewOrderedMap(byXY)
+	m.Put(point{2, 1}, "c")
+	m.Put(point{1, 5}, "b")
+	m.Put(point{1, 2}, "a")
+	m.Put(point{2, 1}, "C")
+
+	if m.Len() != 3 {
+		t.Errorf("Len() = %v, want 3", m.Len())
+	}
+
+	k, v, err := m.Min()
+	if err != nil || k != (point{1, 2}) || v != "a" {
+		t.Errorf("Min() = %v, %v, %v, want {1 2}, a, nil", k, v, err)
+	}
+	k, v, err = m.Max()
+	if err != nil || k != (point{2, 1}) || v != "C" {
+		t.Errorf("Max() = %v, %v, %v, want {2 1}, C, nil", k, v, err)
+	}
+}
+
+func Test_ordered_map_ascend_stops(t *testing.T) {
+	m := NewOrderedMap(TimeComparator)
+	epoch := time.Unix(0, 0)
+	for i := 9; i >= 0; i-- {
+		m.Put(epoch.Add(time.Duration(i)*time.Hour), i)
+	}
+
+	var visited []interface{}
+	m.Ascend(func(_, value interface{}) bool {
+		visited = append(visited, value)
+		return len(visited) == 3
+	})
+
+	want := []interface{}{0, 1, 2}
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("visited = %v, want %v", visited, want)
+	}
+}
+
+func Test_ordered_map_empty(t *testing.T) {
+	m := NewOrderedMap(IntComparator)
+	if _, _, err := m.Min(); err != ErrNilTree {
+		t.Errorf("Min() err = %v, want ErrNilTree", err)
+	}
+	if _, _, err := m.Max(); err != ErrNilTree {
+		t.Errorf("Max() err = %v, want ErrNilTree", err)
+	}
+	if _, err := m.Get(1); err != ErrNotFound {
+		t.Errorf("Get(1) err = %v, want ErrNotFound", err)
+	}
+	if err := m.Delete(1); err != ErrNotFound {
+		t.Errorf("Delete(1) err = %v, want ErrNotFound", err)
+	}
+}
+
+// checkOrderedMap verifies the ordering and left-leaning red-black colouring returning the black height. A nil
+// bound is unbounded.
+func checkOrderedMap(m *OrderedMap, n *rbNode, lo, hi interface{}) (int, error) {
+	if n == nil {
+		return 1, nil
+	}
+	key := m.keys[n.value]
+	if (lo != nil && m.compare(key, lo) <= 0) || (hi != nil && m.compare(key, hi) >= 0) {
+		return 0, invariantError("key out of order")
+	}
+	if isRed(n.right) {
+		return 0, invariantError("red right link")
+	}
+	if isRed(n) && isRed(n.left) {
+		return 0, invariantError("consecutive red links")
+	}
+
+	l, err := checkOrderedMap(m, n.left, lo, key)
+	if err != nil {
+		return 0, err
+	}
+	r, err := checkOrderedMap(m, n.right, key, hi)
+	if err != nil {
+		return 0, err
+	}
+	if l != r {
+		return 0, invariantError("unequal black height")
+	}
+
+	if !isRed(n) {
+		l++
+	}
+	return l, nil
+}
@@ -0,0 +1,211 @@
+package tree
+
+// PersistentMap is an immutable ordered map backed by an AVL tree. Put and Delete copy only the path from the root
+// to the change returning a new version that shares every other node with the original, so keeping old versions
+// as snapshots costs O(log n) per update.
+type PersistentMap struct {
+	compare Comparator
+	root    *persistentNode
+	size    int
+}
+
+// persistentNode is never modified once created.
+type persistentNode struct {
+	key    interface{}
+	value  interface{}
+	height int
+	left   *persistentNode
+	right  *persistentNode
+}
+
+// NewPersistentMap creates an empty map ordered by compare.
+func NewPersistentMap(compare Comparator) *PersistentMap {
+	return &PersistentMap{compare: compare}
+}
+
+// Put returns a version of the map with key associated to value.
+func (m *PersistentMap) Put(key, value interface{}) *PersistentMap {
+	root, added := m.put(m.root, key, value)
+	size := m.size
+	if added {
+		size++
+	}
+	return &PersistentMap{compare: m.compare, root: root, size: size}
+}
+
+// Delete returns a version of the map without key. The original map is returned with ErrNotFound when the key is
+// not present.
+func (m *PersistentMap) Delete(key interface{}) (*PersistentMap, error) {
+	root, deleted := m.delete(m.root, key)
+	if !deleted {
+		return m, ErrNotFound
+	}
+	return &PersistentMap{compare: m.compare, root: root, size: m.size - 1}, nil
+}
+
+// Get returns the value associated with key.
+func (m *PersistentMap) Get(key interface{}) (interface{}, error) {
+	n := m.root
+	for n != nil {
+		c := m.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Min returns the smallest key and its value.
+func (m *PersistentMap) Min() (interface{}, interface{}, error) {
+	if m.root == nil {
+		return nil, nil, ErrNilTree
+	}
+	n := persistentMin(m.root)
+	return n.key, n.value, nil
+}
+
+// Max returns the largest key and its value.
+func (m *PersistentMap) Max() (interface{}, interface{}, error) {
+	n := m.root
+	if n == nil {
+		return nil, nil, ErrNilTree
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, nil
+}
+
+// Len returns the number of keys in the map.
+func (m *PersistentMap) Len() int {
+	return m.size
+}
+
+// Ascend calls fn for each key and value in ascending key order. Returning true will terminate the iteration.
+func (m *PersistentMap) Ascend(fn func(key, value interface{}) bool) {
+	var stack []*persistentNode
+	n := m.root
+	for n != nil || len(stack) > 0 {
+		for n != nil {
+			stack = append(stack, n)
+			n = n.left
+		}
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if fn(n.key, n.value) {
+			return
+		}
+		n = n.right
+	}
+}
+
+func (m *PersistentMap) put(n *persistentNode, key, value interface{}) (*persistentNode, bool) {
+	if n == nil {
+		return newPersistentNode(key, value, nil, nil), true
+	}
+
+	var added bool
+	c := m.compare(key, n.key)
+	switch {
+	case c < 0:
+		var l *persistentNode
+		l, added = m.put(n.left, key, value)
+		return persistentBalance(n.key, n.value, l, n.right), added
+	case c > 0:
+		var r *persistentNode
+		r, added = m.put(n.right, key, value)
+		return persistentBalance(n.key, n.value, n.left, r), added
+	}
+	return newPersistentNode(key, value, n.left, n.right), false
+}
+
+func (m *PersistentMap) delete(n *persistentNode, key interface{}) (*persistentNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	c := m.compare(key, n.key)
+	switch {
+	case c < 0:
+		l, deleted := m.delete(n.left, key)
+		if !deleted {
+			return n, false
+		}
+		return persistentBalance(n.key, n.value, l, n.right), true
+	case c > 0:
+		r, deleted := m.delete(n.right, key)
+		if !deleted {
+			return n, false
+		}
+		return persistentBalance(n.key, n.value, n.left, r), true
+	}
+
+	if n.left == nil {
+		return n.right, true
+	}
+	if n.right == nil {
+		return n.left, true
+	}
+	// the in-order successor takes the place of the deleted node.
+	succ := persistentMin(n.right)
+	return persistentBalance(succ.key, succ.value, n.left, persistentDeleteMin(n.right)), true
+}
+
+func persistentDeleteMin(n *persistentNode) *persistentNode {
+	if n.left == nil {
+		return n.right
+	}
+	return persistentBalance(n.key, n.value, persistentDeleteMin(n.left), n.right)
+}
+
+func persistentMin(n *persistentNode) *persistentNode {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func persistentHeight(n *persistentNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func newPersistentNode(key, value interface{}, left, right *persistentNode) *persistentNode {
+	h := persistentHeight(left)
+	if r := persistentHeight(right); r > h {
+		h = r
+	}
+	return &persistentNode{key: key, value: value, height: h + 1, left: left, right: right}
+}
+
+// persistentBalance creates a node from key, value and subtrees whose heights differ by at most two, rotating with
+// new nodes where needed to restore the AVL invariant.
+func persistentBalance(key, value interface{}, l, r *persistentNode) *persistentNode {
+	hl, hr := persistentHeight(l), persistentHeight(r)
+	switch {
+	case hl > hr+1:
+		if persistentHeight(l.left) >= persistentHeight(l.right) {
+			return newPersistentNode(l.key, l.value, l.left, newPersistentNode(key, value, l.right, r))
+		}
+		lr := l.right
+		return newPersistentNode(lr.key, lr.value,
+			newPersistentNode(l.key, l.value, l.left, lr.left),
+			newPersistentNode(key, value, lr.right, r))
+	case hr > hl+1:
+		if persistentHeight(r.right) >= persistentHeight(r.left) {
+			return newPersistentNode(r.key, r.value, newPersistentNode(key, value, l, r.left), r.right)
+		}
+		rl := r.left
+		return newPersistentNode(rl.key, rl.value,
+			newPersistentNode(key, value, l, rl.left),
+			newPersistentNode(r.key, r.value, rl.right, r.right))
+	}
+	return newPersistentNode(key, value, l, r)
+}
@@ -0,0 +1,163 @@
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func Test_persistent_map_versions_are_independent(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+
+	type version struct {
+		m      *PersistentMap
+		oracle map[int]int
+	}
+	versions := []version{{NewPersistentMap(IntComparator), map[int]int{}}}
+
+	for i := 0; i < 1000; i++ {
+		prev := versions[len(versions)-1]
+		oracle := make(map[int]int, len(prev.oracle))
+		for k, v := range prev.oracle {
+			oracle[k] = v
+		}
+
+		k := r.Intn(200)
+		var next *PersistentMap
+		if r.Intn(3) == 0 {
+			var err error
+			next, err = prev.m.Delete(k)
+			_, present := oracle[k]
+			if present && err != nil {
+				t.Fatalf("Delete(%v) = %v, want nil", k, err)
+			}
+			if !present && (err != ErrNotFound || next != prev.m) {
+				t.Fatalf("Delete(%v) = %v, want original map and ErrNotFound", k, err)
+			}
+			delete(oracle, k)
+		} else {
+			next = prev.m.Put(k, i)
+			oracle[k] = i
+		}
+
+		if _, err := checkPersistent(next.root, next.compare, nil, nil); err != nil {
+			t.Fatalf("op %v: %v", i, err)
+		}
+		versions = append(versions, version{next, oracle})
+	}
+
+	// every earlier version must be unaffected by the updates that followed it.
+	for i, v := range versions {
+		if v.m.Len() != len(v.oracle) {
+			t.Fatalf("version %v: Len() = %v, want %v", i, v.m.Len(), len(v.oracle))
+		}
+		var count int
+		v.m.Ascend(func(key, value interface{}) bool {
+			if want, ok := v.oracle[key.(int)]; !ok || want != value {
+				t.Fatalf("version %v: %v = %v, want %v (present %v)", i, key, value, want, ok)
+			}
+			count++
+			return false
+		})
+		if count != len(v.oracle) {
+			t.Fatalf("version %v: visited %v keys, want %v", i, count, len(v.oracle))
+		}
+	}
+}
+
+func Test_persistent_map_shares_structure(t *testing.T) {
+	m := NewPersistentMap(IntComparator)
+	for v := 0; v < 1024; v++ {
+		m = m.Put(v, v)
+	}
+
+	m2 := m.Put(0, "zero")
+	if got, _ := m.Get(0); got != 0 {
+		t.Errorf("original Get(0) = %v, want 0", got)
+	}
+	if got, _ := m2.Get(0); got != "zero" {
+		t.Errorf("updated Get(0) = %v, want zero", got)
+	}
+
+	shared := nodes(m.root)
+	var copied int
+	for n := range nodes(m2.root) {
+		if !shared[n] {
+			copied++
+		}
+	}
+	// only the path to the minimum is copied.
+	if copied > persistentHeight(m.root) {
+		t.Errorf("copied %v nodes, want <= %v", copied, persistentHeight(m.root))
+	}
+}
+
+func Test_persistent_map_empty(t *testing.T) {
+	m := NewPersistentMap(StringComparator)
+	if _, _, err := m.Min(); err != ErrNilTree {
+		t.Errorf("Min() err = %v, want ErrNilTree", err)
+	}
+	if _, _, err := m.Max(); err != ErrNilTree {
+		t.Errorf("Max() err = %v, want ErrNilTree", err)
+	}
+	if _, err := m.Get("a"); err != ErrNotFound {
+		t.Errorf("Get(a) err = %v, want ErrNotFound", err)
+	}
+
+	m2 := m.Put("b", 2).Put("a", 1).Put("c", 3)
+	if k, v, _ := m2.Min(); k != "a" || v != 1 {
+		t.Errorf("Min() = %v, %v, want a, 1", k, v)
+	}
+	if k, v, _ := m2.Max(); k != "c" || v != 3 {
+		t.Errorf("Max() = %v, %v, want c, 3", k, v)
+	}
+	if m.Len() != 0 {
+		t.Errorf("original Len() = %v, want 0", m.Len())
+	}
+}
+
+func nodes(n *persistentNode) map[*persistentNode]bool {
+	seen := map[*persistentNode]bool{}
+	var visit func(n *persistentNode)
+	visit = func(n *persistentNode) {
+		if n == nil {
+			return
+		}
+		seen[n] = true
+		visit(n.left)
+		visit(n.right)
+	}
+	visit(n)
+	return seen
+}
+
+// checkPersistent verifies the ordering, recorded heights and balance of the subtree returning its height. A nil
+// bound is unbounded.
+func checkPersistent(n *persistentNode, compare Comparator, lo, hi interface{}) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if (lo != nil && compare(n.key, lo) <= 0) || (hi != nil && compare(n.key, hi) >= 0) {
+		return 0, invariantError("key out of order")
+	}
+
+	l, err := checkPersistent(n.left, compare, lo, n.key)
+	if err != nil {
+		return 0, err
+	}
+	r, err := checkPersistent(n.right, compare, n.key, hi)
+	if err != nil {
+		return 0, err
+	}
+
+	if l-r > 1 || r-l > 1 {
+		return 0, invariantError("subtree heights differ by more than one")
+	}
+	h := l + 1
+	if r > l {
+		h = r + 1
+	}
+	if n.height != h {
+		return 0, invariantError("recorded height incorrect")
+	}
+	return h, nil
+}
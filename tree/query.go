@@ -0,0 +1,92 @@
+package tree
+
+// Range returns the nodes with values between lo and hi inclusive in ascending order. Subtrees outside of the
+// range are not visited.
+func Range(t *BinaryTree, lo, hi int) []*BinaryTree {
+	var nodes []*BinaryTree
+	var visit func(n *BinaryTree)
+	visit = func(n *BinaryTree) {
+		if n == nil {
+			return
+		}
+		if lo < n.Value {
+			visit(n.Left)
+		}
+		if lo <= n.Value && n.Value <= hi {
+			nodes = append(nodes, n)
+		}
+		if n.Value < hi {
+			visit(n.Right)
+		}
+	}
+	visit(t)
+	return nodes
+}
+
+// Floor returns the node with the largest value less than or equal to v.
+func Floor(t *BinaryTree, v int) (*BinaryTree, error) {
+	var floor *BinaryTree
+	for t != nil {
+		if t.Value == v {
+			return t, nil
+		}
+		if t.Value < v {
+			floor = t
+			t = t.Right
+		} else {
+			t = t.Left
+		}
+	}
+
+	if floor == nil {
+		return nil, ErrNotFound
+	}
+	return floor, nil
+}
+
+// Ceiling returns the node with the smallest value greater than or equal to v.
+func Ceiling(t *BinaryTree, v int) (*BinaryTree, error) {
+	var ceiling *BinaryTree
+	for t != nil {
+		if t.Value == v {
+			return t, nil
+		}
+		if t.Value > v {
+			ceiling = t
+			t = t.Left
+		} else {
+			t = t.Right
+		}
+	}
+
+	if ceiling == nil {
+		return nil, ErrNotFound
+	}
+	return ceiling, nil
+}
+
+// Successor returns the node following n in order or nil if n is the maximum. It relies on Parent pointers.
+func Successor(n *BinaryTree) *BinaryTree {
+	if n.Right != nil {
+		s, _ := Min(n.Right)
+		return s
+	}
+	p := n.Parent
+	for p != nil && n == p.Right {
+		n, p = p, p.Parent
+	}
+	return p
+}
+
+// Predecessor returns the node preceding n in order or nil if n is the minimum. It relies on Parent pointers.
+func Predecessor(n *BinaryTree) *BinaryTree {
+	if n.Left != nil {
+		s, _ := Max(n.Left)
+		return s
+	}
+	p := n.Parent
+	for p != nil && n == p.Left {
+		n, p = p, p.Parent
+	}
+	return p
+}
@@ -0,0 +1,99 @@
+package tree_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/nfisher/goalgo/tree"
+)
+
+func Test_queries_against_sorted_slice(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+
+	for run := 0; run < 50; run++ {
+		var root *BinaryTree
+		var oracle []int
+		for i := r.Intn(64); i >= 0; i-- {
+			v := r.Intn(200)
+			root = Insert(root, v)
+			idx := sort.SearchInts(oracle, v)
+			if idx == len(oracle) || oracle[idx] != v {
+				oracle = append(oracle, 0)
+				copy(oracle[idx+1:], oracle[idx:])
+				oracle[idx] = v
+			}
+		}
+
+		for q := 0; q < 50; q++ {
+			v := r.Intn(220) - 10
+			idx := sort.SearchInts(oracle, v)
+
+			floor, err := Floor(root, v)
+			switch {
+			case idx < len(oracle) && oracle[idx] == v:
+				ok(t, err)
+				equals(t, v, floor.Value)
+			case idx > 0:
+				ok(t, err)
+				equals(t, oracle[idx-1], floor.Value)
+			default:
+				equals(t, ErrNotFound, err)
+			}
+
+			ceiling, err := Ceiling(root, v)
+			if idx < len(oracle) {
+				ok(t, err)
+				equals(t, oracle[idx], ceiling.Value)
+			} else {
+				equals(t, ErrNotFound, err)
+			}
+
+			lo, hi := v, v+r.Intn(60)
+			var want []int
+			for _, x := range oracle {
+				if lo <= x && x <= hi {
+					want = append(want, x)
+				}
+			}
+			var got []int
+			for _, n := range Range(root, lo, hi) {
+				got = append(got, n.Value)
+			}
+			equals(t, want, got)
+		}
+
+		for i, v := range oracle {
+			n, err := Search(root, v)
+			ok(t, err)
+
+			succ := Successor(n)
+			if i == len(oracle)-1 {
+				assert(t, succ == nil, "Successor(%v) = %v, want nil", v, succ)
+			} else {
+				equals(t, oracle[i+1], succ.Value)
+			}
+
+			pred := Predecessor(n)
+			if i == 0 {
+				assert(t, pred == nil, "Predecessor(%v) = %v, want nil", v, pred)
+			} else {
+				equals(t, oracle[i-1], pred.Value)
+			}
+		}
+	}
+}
+
+func Test_range_prunes_subtrees(t *testing.T) {
+	root := sample()
+	// the subtrees rooted at 2 and 7 lie outside [5, 6], poison them with in range values so visiting either one
+	// shows up in the result.
+	root.Left.Value = 5
+	root.Right.Right.Value = 6
+
+	var found []int
+	for _, n := range Range(root, 5, 6) {
+		found = append(found, n.Value)
+	}
+	equals(t, []int{5, 6}, found)
+}
@@ -0,0 +1,234 @@
+package tree
+
+// RedBlack is a left-leaning red-black tree, a self-balancing binary search tree equivalent to a 2-3 tree that
+// keeps Insert, Search and Delete at O(log n) regardless of insertion order. The zero value is an empty tree.
+type RedBlack struct {
+	root *rbNode
+	size int
+}
+
+type rbNode struct {
+	value int
+	red   bool
+	left  *rbNode
+	right *rbNode
+}
+
+// Insert adds a value to the tree, values already present are ignored.
+func (t *RedBlack) Insert(v int) {
+	var found *rbNode
+	t.root, found = intRB.insert(t.root, v)
+	t.root.red = false
+	if found == nil {
+		t.size++
+	}
+}
+
+// Search finds the value in the tree.
+func (t *RedBlack) Search(v int) (int, error) {
+	n := t.root
+	for n != nil {
+		switch {
+		case v < n.value:
+			n = n.left
+		case v > n.value:
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+	return 0, ErrNotFound
+}
+
+// Delete removes the value from the tree.
+func (t *RedBlack) Delete(v int) error {
+	if _, err := t.Search(v); err != nil {
+		return err
+	}
+
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = intRB.delete(t.root, v)
+	if t.root != nil {
+		t.root.red = false
+	}
+	t.size--
+	return nil
+}
+
+// Min returns the minimum value in the tree.
+func (t *RedBlack) Min() (int, error) {
+	if t.root == nil {
+		return 0, ErrNilTree
+	}
+	return rbMin(t.root).value, nil
+}
+
+// Max returns the maximum value in the tree.
+func (t *RedBlack) Max() (int, error) {
+	n := t.root
+	if n == nil {
+		return 0, ErrNilTree
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, nil
+}
+
+// Len returns the number of values in the tree.
+func (t *RedBlack) Len() int {
+	return t.size
+}
+
+func isRed(n *rbNode) bool {
+	return n != nil && n.red
+}
+
+// rbOps parameterises the red-black balancing code. compare orders node values, nil uses the natural order of the
+// values. Trees keyed by something other than an int store an id per node and compare through a side table.
+type rbOps struct {
+	compare func(a, b int) int
+}
+
+// intRB orders plain int values.
+var intRB = &rbOps{}
+
+// insert adds v to the subtree rooted at h, returning the new subtree root and the node already holding an equal
+// value or nil if v was added.
+func (o *rbOps) insert(h *rbNode, v int) (*rbNode, *rbNode) {
+	if h == nil {
+		return &rbNode{value: v, red: true}, nil
+	}
+
+	var found *rbNode
+	switch c := o.compareTo(v, h); {
+	case c < 0:
+		h.left, found = o.insert(h.left, v)
+	case c > 0:
+		h.right, found = o.insert(h.right, v)
+	default:
+		found = h
+	}
+
+	return h.fixUp(), found
+}
+
+// delete removes v from the subtree rooted at h, v must be present.
+func (o *rbOps) delete(h *rbNode, v int) *rbNode {
+	if o.compareTo(v, h) < 0 {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = h.moveRedLeft()
+		}
+		h.left = o.delete(h.left, v)
+		return h.fixUp()
+	}
+
+	if isRed(h.left) {
+		h = h.rotateRight()
+	}
+	if o.compareTo(v, h) == 0 && h.right == nil {
+		return nil
+	}
+	if !isRed(h.right) && !isRed(h.right.left) {
+		h = h.moveRedRight()
+	}
+	if o.compareTo(v, h) == 0 {
+		h.value = rbMin(h.right).value
+		h.right = rbDeleteMin(h.right)
+	} else {
+		h.right = o.delete(h.right, v)
+	}
+	return h.fixUp()
+}
+
+// compareTo orders v against the value of h, plain int trees are compared inline without an indirect call.
+func (o *rbOps) compareTo(v int, h *rbNode) int {
+	switch {
+	case o.compare != nil:
+		return o.compare(v, h.value)
+	case v < h.value:
+		return -1
+	case v > h.value:
+		return 1
+	}
+	return 0
+}
+
+func rbDeleteMin(h *rbNode) *rbNode {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = h.moveRedLeft()
+	}
+	h.left = rbDeleteMin(h.left)
+	return h.fixUp()
+}
+
+func rbMin(n *rbNode) *rbNode {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// fixUp restores the left-leaning invariants on the way back up the tree.
+func (h *rbNode) fixUp() *rbNode {
+	if isRed(h.right) && !isRed(h.left) {
+		h = h.rotateLeft()
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = h.rotateRight()
+	}
+	if isRed(h.left) && isRed(h.right) {
+		h.flip()
+	}
+	return h
+}
+
+func (h *rbNode) rotateLeft() *rbNode {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *rbNode) rotateRight() *rbNode {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *rbNode) flip() {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+// moveRedLeft borrows from the right sibling so the left child or one of its children is red.
+func (h *rbNode) moveRedLeft() *rbNode {
+	h.flip()
+	if isRed(h.right.left) {
+		h.right = h.right.rotateRight()
+		h = h.rotateLeft()
+		h.flip()
+	}
+	return h
+}
+
+// moveRedRight borrows from the left sibling so the right child or one of its children is red.
+func (h *rbNode) moveRedRight() *rbNode {
+	h.flip()
+	if isRed(h.left.left) {
+		h = h.rotateRight()
+		h.flip()
+	}
+	return h
+}
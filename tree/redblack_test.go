@@ -0,0 +1,70 @@
+package tree
+
+import "testing"
+
+func Test_red_black_against_oracle(t *testing.T) {
+	var tr RedBlack
+	exercise(t, &tr, func() error {
+		if isRed(tr.root) {
+			return invariantError("root is red")
+		}
+		_, err := checkRedBlack(tr.root, minInt, maxInt)
+		return err
+	})
+}
+
+func Test_red_black_sorted_insert_stays_shallow(t *testing.T) {
+	var tr RedBlack
+	for v := 0; v < 1<<12; v++ {
+		tr.Insert(v)
+	}
+
+	// a red-black tree is at most 2 log2(n) high.
+	if h := rbDepth(tr.root); h > 24 {
+		t.Errorf("height = %v, want <= 24", h)
+	}
+}
+
+func rbDepth(n *rbNode) int {
+	if n == nil {
+		return 0
+	}
+	l, r := rbDepth(n.left), rbDepth(n.right)
+	if l > r {
+		return l + 1
+	}
+	return r + 1
+}
+
+// checkRedBlack verifies the ordering and left-leaning red-black colouring returning the black height.
+func checkRedBlack(n *rbNode, lo, hi int) (int, error) {
+	if n == nil {
+		return 1, nil
+	}
+	if n.value < lo || n.value > hi {
+		return 0, invariantError("value out of order")
+	}
+	if isRed(n.right) {
+		return 0, invariantError("red right link")
+	}
+	if isRed(n) && isRed(n.left) {
+		return 0, invariantError("consecutive red links")
+	}
+
+	l, err := checkRedBlack(n.left, lo, n.value-1)
+	if err != nil {
+		return 0, err
+	}
+	r, err := checkRedBlack(n.right, n.value+1, hi)
+	if err != nil {
+		return 0, err
+	}
+	if l != r {
+		return 0, invariantError("unequal black height")
+	}
+
+	if !isRed(n) {
+		l++
+	}
+	return l, nil
+}
@@ -0,0 +1,98 @@
+package tree
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteASCII draws the tree with ASCII branches, the left child is drawn above the right child. When a node
+// has a single child the missing one is drawn as nil so the sides can be told apart.
+//
+//	4
+//	|-- 2
+//	|   |-- 1
+//	|   `-- 3
+//	`-- 6
+//	    |-- nil
+//	    `-- 7
+func WriteASCII(w io.Writer, tree *BinaryTree) error {
+	ew := &errWriter{w: w}
+	if tree == nil {
+		ew.printf("nil\n")
+		return ew.err
+	}
+
+	ew.printf("%d\n", tree.Value)
+	writeBranches(ew, tree, "")
+	return ew.err
+}
+
+func writeBranches(ew *errWriter, n *BinaryTree, prefix string) {
+	if n.Left == nil && n.Right == nil {
+		return
+	}
+
+	for _, c := range []struct {
+		child  *BinaryTree
+		branch string
+		indent string
+	}{
+		{n.Left, "|-- ", "|   "},
+		{n.Right, "`-- ", "    "},
+	} {
+		if c.child == nil {
+			ew.printf("%s%snil\n", prefix, c.branch)
+			continue
+		}
+		ew.printf("%s%s%d\n", prefix, c.branch, c.child.Value)
+		writeBranches(ew, c.child, prefix+c.indent)
+	}
+}
+
+// WriteDOT exports the tree as a Graphviz digraph. Missing children of a node with a single child are drawn as
+// points to keep the left and right sides apart.
+func WriteDOT(w io.Writer, tree *BinaryTree) error {
+	ew := &errWriter{w: w}
+	ew.printf("digraph tree {\n")
+	if tree != nil {
+		var nodes, points int
+		writeDOTNode(ew, tree, &nodes, &points)
+	}
+	ew.printf("}\n")
+	return ew.err
+}
+
+// writeDOTNode writes n and its subtree numbering nodes in pre-order and returns the id given to n.
+func writeDOTNode(ew *errWriter, n *BinaryTree, nodes, points *int) int {
+	id := *nodes
+	*nodes++
+	ew.printf("\tn%d [label=\"%d\"];\n", id, n.Value)
+
+	if n.Left == nil && n.Right == nil {
+		return id
+	}
+	for _, c := range []*BinaryTree{n.Left, n.Right} {
+		if c == nil {
+			ew.printf("\tp%d [shape=point];\n", *points)
+			ew.printf("\tn%d -> p%d;\n", id, *points)
+			*points++
+			continue
+		}
+		child := writeDOTNode(ew, c, nodes, points)
+		ew.printf("\tn%d -> n%d;\n", id, child)
+	}
+	return id
+}
+
+// errWriter formats to w until the first error which is retained in err.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, a ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, a...)
+}
@@ -0,0 +1,139 @@
+package tree_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/nfisher/goalgo/tree"
+)
+
+func Test_write_ascii(t *testing.T) {
+	root := sample()
+	root, _, err := Delete(root, 5)
+	ok(t, err)
+
+	var buf bytes.Buffer
+	ok(t, WriteASCII(&buf, root))
+
+	want := "4\n" +
+		"|-- 2\n" +
+		"|   |-- 1\n" +
+		"|   `-- 3\n" +
+		"`-- 6\n" +
+		"    |-- nil\n" +
+		"    `-- 7\n"
+	equals(t, want, buf.String())
+
+	buf.Reset()
+	ok(t, WriteASCII(&buf, nil))
+	equals(t, "nil\n", buf.String())
+}
+
+func Test_write_dot(t *testing.T) {
+	root := Insert(nil, 2)
+	Insert(root, 1)
+	Insert(root, 4)
+	Insert(root, 3)
+
+	var buf bytes.Buffer
+	ok(t, WriteDOT(&buf, root))
+
+	want := `digraph tree {
+	n0 [label="2"];
+	n1 [label="1"];
+	n0 -> n1;
+	n2 [label="4"];
+	n3 [label="3"];
+	n2 -> n3;
+	p0 [shape=point];
+	n2 -> p0;
+	n0 -> n2;
+}
+`
+	equals(t, want, buf.String())
+}
+
+func Test_json_round_trip(t *testing.T) {
+	root, scope := gen()
+
+	b, err := json.Marshal(root)
+	ok(t, err)
+
+	var decoded BinaryTree
+	ok(t, json.Unmarshal(b, &decoded))
+
+	var want, got []int
+	InOrder(root, func(n *BinaryTree, _ int) bool {
+		want = append(want, n.Value)
+		return false
+	})
+	InOrder(&decoded, func(n *BinaryTree, _ int) bool {
+		got = append(got, n.Value)
+		if n.Left != nil {
+			assert(t, n.Left.Parent == n, "left child of %v has wrong parent", n.Value)
+		}
+		if n.Right != nil {
+			assert(t, n.Right.Parent == n, "right child of %v has wrong parent", n.Value)
+		}
+		return false
+	})
+	equals(t, want, got)
+	equals(t, len(scope.values), len(got))
+
+	// parent pointers allow iteration over the decoded tree.
+	var ascending []int
+	for it := Ascend(&decoded); it.Next(); {
+		ascending = append(ascending, it.Node().Value)
+	}
+	equals(t, want, ascending)
+}
+
+func Test_json_encoding(t *testing.T) {
+	b, err := json.Marshal(sample())
+	ok(t, err)
+	equals(t, `{"value":4,"left":{"value":2,"left":{"value":1},"right":{"value":3}},"right":{"value":6,"left":{"value":5},"right":{"value":7}}}`, string(b))
+}
+
+func Test_json_rejects_unordered(t *testing.T) {
+	var tr BinaryTree
+	err := json.Unmarshal([]byte(`{"value":4,"left":{"value":5}}`), &tr)
+	assert(t, errors.Is(err, ErrUnsorted), "err = %v, want ErrUnsorted", err)
+}
+
+func Test_json_null_leaves_tree_untouched(t *testing.T) {
+	tr := BinaryTree{Value: 7, Left: &BinaryTree{Value: 3}}
+	err := tr.UnmarshalJSON([]byte(`null`))
+	ok(t, err)
+	equals(t, 7, tr.Value)
+	equals(t, 3, tr.Left.Value)
+
+	err = tr.UnmarshalJSON([]byte(``))
+	assert(t, err != nil, "empty document err = nil, want error")
+	equals(t, 7, tr.Value)
+
+	err = json.Unmarshal([]byte(`{"value":1,"left":null}`), &tr)
+	ok(t, err)
+	equals(t, 1, tr.Value)
+}
+
+type failingWriter struct{}
+
+var errWrite = errors.New("write failed")
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
+
+func Test_writers_return_errors(t *testing.T) {
+	for n, write := range map[string]func(w *failingWriter) error{
+		"ascii":  func(w *failingWriter) error { return WriteASCII(w, sample()) },
+		"dot":    func(w *failingWriter) error { return WriteDOT(w, sample()) },
+		"levels": func(w *failingWriter) error { return WriteLevels(w, sample()) },
+	} {
+		t.Run(n, func(t *testing.T) {
+			equals(t, errWrite, write(&failingWriter{}))
+		})
+	}
+}
@@ -69,66 +69,59 @@ func Search(t *BinaryTree, v int) (*BinaryTree, error) {
 	return t, nil
 }
 
-// Delete removes the value from the tree.
-func Delete(t *BinaryTree, v int) (*BinaryTree, error) {
-	var newChild *BinaryTree
-
+// Delete removes the value from the tree returning the root of the updated tree and the removed node. The root
+// changes when the value deleted is at the root. When t is a subtree the returned root is the node that took t's
+// place under its parent. The removed node is detached from the tree.
+func Delete(t *BinaryTree, v int) (root *BinaryTree, removed *BinaryTree, err error) {
 	del, err := Search(t, v)
 	if err != nil {
-		return nil, err
+		return t, nil, err
 	}
 
-	delParent := del.Parent
-	delLeft := del.Left
-	delRight := del.Right
-
-	if delLeft == nil && delRight == nil {
-		newChild = nil
-	} else if delLeft != nil && delRight != nil {
-		min, err := Min(delRight)
-		if err != nil {
-			return nil, err
-		}
-		minParent := min.Parent
-		minRight := min.Right
-		minParent.Left = minRight
-
-		if minRight != nil {
-			minRight.Parent = minParent
+	root = t
+	if del.Left == nil {
+		root = transplant(root, del, del.Right)
+	} else if del.Right == nil {
+		root = transplant(root, del, del.Left)
+	} else {
+		// the successor has no left child so it can take the deleted node's place.
+		succ, _ := Min(del.Right)
+		if succ.Parent != del {
+			root = transplant(root, succ, succ.Right)
+			succ.Right = del.Right
+			succ.Right.Parent = succ
 		}
+		root = transplant(root, del, succ)
+		succ.Left = del.Left
+		succ.Left.Parent = succ
+	}
 
-		if min == delRight {
-			delRight = nil
-		}
+	del.Parent = nil
+	del.Left = nil
+	del.Right = nil
 
-		newChild = min
-	} else if delLeft != nil {
-		newChild = delLeft
-		delLeft = nil
-	} else if delRight != nil {
-		newChild = delRight
-		delRight = nil
-	}
+	return root, del, nil
+}
 
-	if newChild != nil {
-		newChild.Parent = delParent
-		newChild.Left = delLeft
-		newChild.Right = delRight
+// transplant replaces the subtree at u with the subtree at v returning the root of the tree, which is v when u
+// was the root.
+func transplant(root, u, v *BinaryTree) *BinaryTree {
+	if u.Parent != nil {
+		if u == u.Parent.Left {
+			u.Parent.Left = v
+		} else {
+			u.Parent.Right = v
+		}
 	}
 
-	if v > delParent.Value {
-		delParent.Right = newChild
+	if v != nil {
+		v.Parent = u.Parent
 	}
 
-	if v < delParent.Value {
-		delParent.Left = newChild
+	if u == root {
+		root = v
 	}
-
-	del.Parent = nil
-	del.Left = nil
-	del.Right = nil
-
-	return del, nil
+	return root
 }
 
 var (
@@ -136,4 +129,12 @@ var (
 	ErrNilTree = errors.New("nil tree invalid")
 	// ErrNotFound is returned when a value is not present in the tree.
 	ErrNotFound = errors.New("value not found in tree")
+	// ErrOutOfRange is returned when a rank is outside of the tree.
+	ErrOutOfRange = errors.New("rank out of range")
+	// ErrInvalidInterval is returned when an interval ends before it starts.
+	ErrInvalidInterval = errors.New("interval ends before it starts")
+	// ErrInvalidDegree is returned when a B-tree degree is less than 2.
+	ErrInvalidDegree = errors.New("degree must be at least 2")
+	// ErrUnsorted is returned when bulk loaded values are not strictly ascending.
+	ErrUnsorted = errors.New("values not in strictly ascending order")
 )
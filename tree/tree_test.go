@@ -1,9 +1,9 @@
 package tree_test
 
 import (
-	"fmt"
 	"math"
 	"math/rand"
+	"reflect"
 	"sort"
 	"testing"
 	"time"
@@ -164,18 +164,6 @@ func Test_nil_check(t *testing.T) {
 	equals(t, ErrNilTree, err)
 }
 
-func PrintTree(tree *BinaryTree, pad string) {
-	if tree == nil {
-		return
-	}
-	fmt.Println(tree.Value)
-	fmt.Printf(pad + "L -> ")
-	PrintTree(tree.Left, pad+" ")
-	fmt.Printf(pad + "R -> ")
-	PrintTree(tree.Right, pad+" ")
-	fmt.Println("")
-}
-
 func Test_delete(t *testing.T) {
 	nums := []int{2, 1, 7, 4, 8, 3, 6, 5}
 
@@ -191,6 +179,7 @@ func Test_delete(t *testing.T) {
 		{"single child", 6, nil, false, []int{2, 1, 7, 4, 8, 3, 5}},
 		{"double child low", 4, nil, false, []int{2, 1, 7, 8, 3, 6, 5}},
 		{"double child high", 7, nil, false, []int{2, 1, 4, 8, 3, 6, 5}},
+		{"root", 2, nil, false, []int{1, 7, 4, 8, 3, 6, 5}},
 	}
 
 	for _, tc := range tt {
@@ -201,7 +190,7 @@ func Test_delete(t *testing.T) {
 				root = Insert(root, v)
 			}
 
-			n, err := Delete(root, tc.value)
+			root, n, err := Delete(root, tc.value)
 			equals(t, tc.err, err)
 			assert(t, (n == nil) == tc.isNil, "n = %v, want %v", n, tc.isNil)
 
@@ -231,3 +220,131 @@ func Test_delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_delete_root_when(t *testing.T) {
+	tt := []struct {
+		name   string
+		values []int
+		root   *int
+	}{
+		{"only node", []int{5}, nil},
+		{"left child only", []int{5, 3}, intp(3)},
+		{"right child only", []int{5, 8}, intp(8)},
+		{"two children", []int{5, 3, 8, 7}, intp(7)},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var root *BinaryTree
+			for _, v := range tc.values {
+				root = Insert(root, v)
+			}
+
+			root, n, err := Delete(root, tc.values[0])
+			ok(t, err)
+			equals(t, tc.values[0], n.Value)
+
+			if tc.root == nil {
+				assert(t, root == nil, "root = %v, want nil", root)
+				return
+			}
+			assert(t, root != nil, "root = nil, want %v", *tc.root)
+			equals(t, *tc.root, root.Value)
+			assert(t, root.Parent == nil, "root parent = %v, want nil", root.Parent)
+			checkTree(t, root, tc.values[1:])
+		})
+	}
+}
+
+func Test_delete_subtree_root(t *testing.T) {
+	var root *BinaryTree
+	for _, v := range []int{5, 3, 8, 7, 9} {
+		root = Insert(root, v)
+	}
+
+	sub, n, err := Delete(root.Right, 8)
+	ok(t, err)
+	equals(t, 8, n.Value)
+	assert(t, sub != nil, "subtree root = nil, want 9")
+	equals(t, 9, sub.Value)
+	assert(t, sub.Parent == root, "subtree parent = %v, want root", sub.Parent)
+	assert(t, root.Right == sub, "root right = %v, want 9", root.Right)
+	checkTree(t, root, []int{5, 3, 7, 9})
+}
+
+func Test_delete_against_sorted_slice(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for run := 0; run < 200; run++ {
+		var root *BinaryTree
+		var oracle []int
+		for i := r.Intn(64); i >= 0; i-- {
+			v := r.Intn(100)
+			root = Insert(root, v)
+			idx := sort.SearchInts(oracle, v)
+			if idx == len(oracle) || oracle[idx] != v {
+				oracle = append(oracle[:idx], append([]int{v}, oracle[idx:]...)...)
+			}
+		}
+
+		for _, i := range r.Perm(len(oracle) + 5) {
+			v := i
+			if i < len(oracle) {
+				v = oracle[i]
+			} else {
+				v = 100 + i
+			}
+
+			var n *BinaryTree
+			var err error
+			before := root
+			root, n, err = Delete(root, v)
+
+			idx := sort.SearchInts(oracle, v)
+			if idx == len(oracle) || oracle[idx] != v {
+				equals(t, ErrNotFound, err)
+				assert(t, root == before, "root changed on failed delete")
+				continue
+			}
+
+			ok(t, err)
+			equals(t, v, n.Value)
+			assert(t, n.Parent == nil && n.Left == nil && n.Right == nil, "removed node not detached")
+			oracle = append(oracle[:idx], oracle[idx+1:]...)
+			checkTree(t, root, oracle)
+		}
+	}
+}
+
+// checkTree verifies the in-order values match the expected set and that every parent pointer is consistent.
+func checkTree(t *testing.T, root *BinaryTree, expected []int) {
+	t.Helper()
+	want := append([]int{}, expected...)
+	sort.Ints(want)
+
+	var got []int
+	var walk func(n *BinaryTree)
+	walk = func(n *BinaryTree) {
+		if n == nil {
+			return
+		}
+		if n.Left != nil && n.Left.Parent != n {
+			t.Fatalf("node %v left child parent = %v", n.Value, n.Left.Parent)
+		}
+		if n.Right != nil && n.Right.Parent != n {
+			t.Fatalf("node %v right child parent = %v", n.Value, n.Right.Parent)
+		}
+		walk(n.Left)
+		got = append(got, n.Value)
+		walk(n.Right)
+	}
+	walk(root)
+
+	if len(got) != len(want) || (len(got) > 0 && !reflect.DeepEqual(got, want)) {
+		t.Fatalf("in-order = %v, want %v", got, want)
+	}
+}
+
+func intp(i int) *int {
+	return &i
+}
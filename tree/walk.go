@@ -0,0 +1,154 @@
+package tree
+
+import "github.com/nfisher/goalgo/queue"
+
+// InOrder visits the left subtree, the node and then the right subtree calling fn for each node. The depth of the
+// root is 0. Returning true from fn will terminate the walk.
+func InOrder(t *BinaryTree, fn SearchFn) {
+	var stack []nodeLevel
+	n, depth := t, 0
+	for n != nil || len(stack) > 0 {
+		for n != nil {
+			stack = append(stack, nodeLevel{n, depth})
+			n = n.Left
+			depth++
+		}
+		nl := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if fn(nl.tree, nl.depth) {
+			return
+		}
+		n, depth = nl.tree.Right, nl.depth+1
+	}
+}
+
+// PreOrder visits the node, the left subtree and then the right subtree calling fn for each node. Returning true
+// from fn will terminate the walk.
+func PreOrder(t *BinaryTree, fn SearchFn) {
+	if t == nil {
+		return
+	}
+
+	stack := []nodeLevel{{t, 0}}
+	for len(stack) > 0 {
+		nl := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if fn(nl.tree, nl.depth) {
+			return
+		}
+		if nl.tree.Right != nil {
+			stack = append(stack, nodeLevel{nl.tree.Right, nl.depth + 1})
+		}
+		if nl.tree.Left != nil {
+			stack = append(stack, nodeLevel{nl.tree.Left, nl.depth + 1})
+		}
+	}
+}
+
+// PostOrder visits the left subtree, the right subtree and then the node calling fn for each node. Returning true
+// from fn will terminate the walk.
+func PostOrder(t *BinaryTree, fn SearchFn) {
+	var stack []nodeLevel
+	var last *BinaryTree
+	n, depth := t, 0
+	for n != nil || len(stack) > 0 {
+		for n != nil {
+			stack = append(stack, nodeLevel{n, depth})
+			n = n.Left
+			depth++
+		}
+		nl := stack[len(stack)-1]
+		if nl.tree.Right != nil && nl.tree.Right != last {
+			n, depth = nl.tree.Right, nl.depth+1
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		if fn(nl.tree, nl.depth) {
+			return
+		}
+		last = nl.tree
+	}
+}
+
+// LevelOrder visits the nodes breadth-first from left to right calling fn for each node. Returning true from fn
+// will terminate the walk.
+func LevelOrder(t *BinaryTree, fn SearchFn) {
+	if t == nil {
+		return
+	}
+
+	q := queue.New()
+	q.Enqueue(&nodeLevel{t, 0})
+	for {
+		v, err := q.Dequeue()
+		if err == queue.ErrNoValues {
+			return
+		}
+
+		nl := v.(*nodeLevel)
+		if fn(nl.tree, nl.depth) {
+			return
+		}
+		if nl.tree.Left != nil {
+			q.Enqueue(&nodeLevel{nl.tree.Left, nl.depth + 1})
+		}
+		if nl.tree.Right != nil {
+			q.Enqueue(&nodeLevel{nl.tree.Right, nl.depth + 1})
+		}
+	}
+}
+
+// Iterator steps through a tree in order using Parent pointers so it needs no auxiliary storage. The tree must not
+// be modified during iteration.
+type Iterator struct {
+	next    *BinaryTree
+	current *BinaryTree
+	last    *BinaryTree
+	step    func(*BinaryTree) *BinaryTree
+}
+
+// Ascend returns an iterator over the tree in ascending order. t may be a subtree, the iterator stops at its maximum
+// rather than following Parent pointers into the rest of the tree.
+//
+//	for it := tree.Ascend(root); it.Next(); {
+//		fmt.Println(it.Node().Value)
+//	}
+func Ascend(t *BinaryTree) *Iterator {
+	var first, last *BinaryTree
+	if t != nil {
+		first, _ = Min(t)
+		last, _ = Max(t)
+	}
+	return &Iterator{next: first, last: last, step: Successor}
+}
+
+// Descend returns an iterator over the tree in descending order. t may be a subtree, the iterator stops at its
+// minimum.
+func Descend(t *BinaryTree) *Iterator {
+	var first, last *BinaryTree
+	if t != nil {
+		first, _ = Max(t)
+		last, _ = Min(t)
+	}
+	return &Iterator{next: first, last: last, step: Predecessor}
+}
+
+// Next advances the iterator returning false when there are no more nodes.
+func (it *Iterator) Next() bool {
+	if it.next == nil {
+		it.current = nil
+		return false
+	}
+	it.current = it.next
+	if it.current == it.last {
+		it.next = nil
+	} else {
+		it.next = it.step(it.next)
+	}
+	return true
+}
+
+// Node returns the node at the current position of the iterator.
+func (it *Iterator) Node() *BinaryTree {
+	return it.current
+}
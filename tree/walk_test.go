@@ -0,0 +1,100 @@
+package tree_test
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/nfisher/goalgo/tree"
+)
+
+// sample returns a complete tree of 1 through 7.
+//
+//	    4
+//	  /   \
+//	 2     6
+//	/ \   / \
+//	1  3 5   7
+func sample() *BinaryTree {
+	var root *BinaryTree
+	for _, v := range []int{4, 2, 6, 1, 3, 5, 7} {
+		root = Insert(root, v)
+	}
+	return root
+}
+
+func collect(walk func(*BinaryTree, SearchFn), root *BinaryTree, limit int) ([]int, []int) {
+	var values, depths []int
+	walk(root, func(n *BinaryTree, depth int) bool {
+		values = append(values, n.Value)
+		depths = append(depths, depth)
+		return len(values) == limit
+	})
+	return values, depths
+}
+
+func Test_walks(t *testing.T) {
+	tt := map[string]struct {
+		walk   func(*BinaryTree, SearchFn)
+		values []int
+		depths []int
+	}{
+		"in order":    {InOrder, []int{1, 2, 3, 4, 5, 6, 7}, []int{2, 1, 2, 0, 2, 1, 2}},
+		"pre order":   {PreOrder, []int{4, 2, 1, 3, 6, 5, 7}, []int{0, 1, 2, 2, 1, 2, 2}},
+		"post order":  {PostOrder, []int{1, 3, 2, 5, 7, 6, 4}, []int{2, 2, 1, 2, 2, 1, 0}},
+		"level order": {LevelOrder, []int{4, 2, 6, 1, 3, 5, 7}, []int{0, 1, 1, 2, 2, 2, 2}},
+	}
+
+	for n, tc := range tt {
+		t.Run(n, func(t *testing.T) {
+			values, depths := collect(tc.walk, sample(), -1)
+			equals(t, tc.values, values)
+			equals(t, tc.depths, depths)
+
+			values, _ = collect(tc.walk, sample(), 3)
+			equals(t, tc.values[:3], values)
+
+			values, _ = collect(tc.walk, nil, -1)
+			assert(t, values == nil, "walk of nil tree visited %v", values)
+		})
+	}
+}
+
+func Test_iterators(t *testing.T) {
+	root, scope := gen()
+	sort.Ints(scope.values)
+
+	var ascending []int
+	for it := Ascend(root); it.Next(); {
+		ascending = append(ascending, it.Node().Value)
+	}
+	equals(t, scope.values, ascending)
+
+	var descending []int
+	for it := Descend(root); it.Next(); {
+		descending = append(descending, it.Node().Value)
+	}
+	for i, v := range descending {
+		equals(t, scope.values[len(scope.values)-1-i], v)
+	}
+	equals(t, len(scope.values), len(descending))
+
+	it := Ascend(nil)
+	assert(t, !it.Next(), "Next() on nil tree should be false")
+	assert(t, it.Node() == nil, "Node() on exhausted iterator should be nil")
+}
+
+func Test_iterators_stop_at_subtree(t *testing.T) {
+	root := sample()
+
+	var ascending []int
+	for it := Ascend(root.Left); it.Next(); {
+		ascending = append(ascending, it.Node().Value)
+	}
+	equals(t, []int{1, 2, 3}, ascending)
+
+	var descending []int
+	for it := Descend(root.Right); it.Next(); {
+		descending = append(descending, it.Node().Value)
+	}
+	equals(t, []int{7, 6, 5}, descending)
+}